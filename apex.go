@@ -0,0 +1,47 @@
+package nodion
+
+import (
+	"context"
+	"strings"
+)
+
+// RelativeName returns the name of a record relative to its zone:
+// `@`, an empty string, and the zone name itself are the apex (`@`),
+// and the zone name suffix of a fully qualified name is removed.
+func RelativeName(name, zoneName string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	switch {
+	case name == "" || name == "@" || name == zoneName:
+		return "@"
+	case zoneName != "" && strings.HasSuffix(name, "."+zoneName):
+		return strings.TrimSuffix(name, "."+zoneName)
+	default:
+		return name
+	}
+}
+
+// SetApexA sets the A record of the apex of a zone:
+// the existing A records of the apex are replaced by a single record.
+// A zero TTL keeps the TTL of the existing records (see SetRecordSet).
+func (c Client) SetApexA(ctx context.Context, zoneID, ip string, ttl int) (*Record, error) {
+	return c.setRecord(ctx, zoneID, Record{RecordType: TypeA, Name: "@", Content: ip, TTL: ttl})
+}
+
+// SetWildcard sets the wildcard record (`*`) of a zone for a record type:
+// the existing wildcard records of this type are replaced by a single record.
+// A zero TTL keeps the TTL of the existing records (see SetRecordSet).
+func (c Client) SetWildcard(ctx context.Context, zoneID string, recordType RecordType, content string, ttl int) (*Record, error) {
+	return c.setRecord(ctx, zoneID, Record{RecordType: recordType, Name: "*", Content: content, TTL: ttl})
+}
+
+// setRecord replaces the records with the same name and type by a single record.
+func (c Client) setRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	records, err := c.SetRecordSet(ctx, zoneID, record.Name, record.RecordType, []string{record.Content}, record.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &records[0], nil
+}
@@ -0,0 +1,49 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelativeName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "", expected: "@"},
+		{name: "@", expected: "@"},
+		{name: "example.com", expected: "@"},
+		{name: "Example.com.", expected: "@"},
+		{name: "www", expected: "www"},
+		{name: "www.example.com.", expected: "www"},
+		{name: "*.example.com", expected: "*"},
+		{name: "www.notexample.com", expected: "www.notexample.com"},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, RelativeName(test.name, "example.com"), test.name)
+	}
+}
+
+func TestClient_SetApexA(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "@", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeA, Name: "example.com", Content: "2.2.2.2", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 3600},
+	})
+
+	record, err := client.SetApexA(context.Background(), "zzz", "3.3.3.3", 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, "@", record.Name)
+
+	expected := []string{
+		"www a 1.1.1.1",
+		"@ a 3.3.3.3",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+}
@@ -0,0 +1,213 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity is the severity of an audit finding.
+type Severity string
+
+// Severities.
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Audit checks.
+const (
+	CheckDanglingCNAME = "dangling-cname"
+	CheckMissingApex   = "missing-apex-address"
+	CheckSPF           = "spf"
+	CheckMultipleSPF   = "multiple-spf"
+	CheckLowTTL        = "low-ttl"
+	CheckACMEChallenge = "acme-challenge"
+)
+
+// lowTTL is the TTL under which a record is reported by AuditZone.
+const lowTTL = 300
+
+// maxSPFLookups is the maximum number of DNS lookups of an SPF record (RFC 7208, section 4.6.4).
+const maxSPFLookups = 10
+
+// AuditReport is the result of AuditZone.
+type AuditReport struct {
+	ZoneID   string
+	ZoneName string
+	Findings []Finding
+}
+
+// HasSeverity reports whether the report has findings with the severity.
+func (r *AuditReport) HasSeverity(severity Severity) bool {
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Finding is a problem found by AuditZone.
+type Finding struct {
+	Severity Severity
+	Check    string
+	Message  string
+	// Record is the record related to the finding (if any).
+	Record *Record
+}
+
+// AuditZone checks a zone for common problems:
+// CNAME records targeting a missing name of the zone, apex without A/AAAA/ALIAS record,
+// invalid or multiple SPF records, very low TTLs, and leftover ACME challenge records.
+func (c Client) AuditZone(ctx context.Context, zoneID string) (*AuditReport, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditRecords(zone, records), nil
+}
+
+func auditRecords(zone *Zone, records []Record) *AuditReport {
+	report := &AuditReport{ZoneID: zone.ID, ZoneName: zone.Name}
+
+	add := func(severity Severity, check string, record *Record, format string, args ...any) {
+		report.Findings = append(report.Findings, Finding{
+			Severity: severity,
+			Check:    check,
+			Message:  fmt.Sprintf(format, args...),
+			Record:   record,
+		})
+	}
+
+	zoneName := strings.ToLower(strings.TrimSuffix(zone.Name, "."))
+
+	names := map[string]struct{}{}
+	spf := map[string][]Record{}
+	apexAddress := false
+
+	for _, record := range records {
+		name := RelativeName(record.Name, zoneName)
+		names[name] = struct{}{}
+
+		switch record.RecordType {
+		case TypeA, TypeAAAA, TypeALIAS:
+			if name == "@" {
+				apexAddress = true
+			}
+
+		case TypeTXT:
+			if strings.HasPrefix(strings.ToLower(JoinTXT(record.Content)), "v=spf1") {
+				spf[name] = append(spf[name], record)
+			}
+		}
+	}
+
+	if !apexAddress {
+		add(SeverityInfo, CheckMissingApex, nil, "the apex %s has no A, AAAA or ALIAS record", zoneName)
+	}
+
+	for i := range records {
+		record := &records[i]
+		name := RelativeName(record.Name, zoneName)
+
+		if record.TTL > 0 && record.TTL < lowTTL {
+			add(SeverityInfo, CheckLowTTL, record, "%s %s has a very low TTL (%d)", record.RecordType, name, record.TTL)
+		}
+
+		switch record.RecordType {
+		case TypeCNAME:
+			target := strings.ToLower(strings.TrimSuffix(record.Content, "."))
+			if target != zoneName && !strings.HasSuffix(target, "."+zoneName) {
+				continue
+			}
+
+			if _, ok := names[RelativeName(target, zoneName)]; !ok {
+				add(SeverityError, CheckDanglingCNAME, record, "CNAME %s targets %s, which has no record", name, target)
+			}
+
+		case TypeTXT:
+			if isACMEChallenge(name) {
+				add(SeverityWarning, CheckACMEChallenge, record, "ACME challenge record %s is probably a leftover", name)
+			}
+		}
+	}
+
+	spfNames := make([]string, 0, len(spf))
+	for name := range spf {
+		spfNames = append(spfNames, name)
+	}
+
+	sort.Strings(spfNames)
+
+	for _, name := range spfNames {
+		set := spf[name]
+
+		if len(set) > 1 {
+			add(SeverityError, CheckMultipleSPF, nil, "%s has %d SPF records (only one is allowed)", name, len(set))
+		}
+
+		for i := range set {
+			for _, problem := range checkSPF(JoinTXT(set[i].Content)) {
+				add(SeverityWarning, CheckSPF, &set[i], "SPF record of %s: %s", name, problem)
+			}
+		}
+	}
+
+	return report
+}
+
+// checkSPF returns the problems of an SPF record.
+func checkSPF(content string) []string {
+	var problems []string
+
+	terms := strings.Fields(content)
+
+	lookups := 0
+	all := false
+	redirect := false
+
+	for _, term := range terms[1:] {
+		mechanism := strings.ToLower(strings.TrimLeft(term, "+-~?"))
+
+		switch {
+		case mechanism == "all":
+			all = true
+		case strings.HasPrefix(mechanism, "redirect="):
+			redirect = true
+			lookups++
+		case strings.HasPrefix(mechanism, "include:"), strings.HasPrefix(mechanism, "exists:"),
+			mechanism == "a", strings.HasPrefix(mechanism, "a:"), strings.HasPrefix(mechanism, "a/"),
+			mechanism == "mx", strings.HasPrefix(mechanism, "mx:"), strings.HasPrefix(mechanism, "mx/"),
+			mechanism == "ptr", strings.HasPrefix(mechanism, "ptr:"):
+			lookups++
+		}
+
+		if mechanism == "ptr" || strings.HasPrefix(mechanism, "ptr:") {
+			problems = append(problems, "the ptr mechanism is deprecated")
+		}
+
+		if term == "+all" || term == "all" {
+			problems = append(problems, "it allows all the senders (+all)")
+		}
+	}
+
+	if !all && !redirect {
+		problems = append(problems, "no all mechanism nor redirect modifier")
+	}
+
+	if lookups > maxSPFLookups {
+		problems = append(problems, fmt.Sprintf("too many DNS lookups (%d > %d)", lookups, maxSPFLookups))
+	}
+
+	return problems
+}
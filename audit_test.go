@@ -0,0 +1,53 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AuditZone(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "web.example.com", TTL: 3600},
+		{RecordType: TypeCNAME, Name: "blog", Content: "example.org", TTL: 3600},
+		{RecordType: TypeCNAME, Name: "shop", Content: "blog.example.com.", TTL: 3600},
+		{RecordType: TypeTXT, Name: "@", Content: `"v=spf1 mx ~all"`, TTL: 3600},
+		{RecordType: TypeTXT, Name: "@", Content: "v=spf1 ptr +all", TTL: 3600},
+		{RecordType: TypeTXT, Name: "_acme-challenge.www", Content: "xxx", TTL: 60},
+	})
+
+	report, err := client.AuditZone(context.Background(), "zzz")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", report.ZoneName)
+	assert.True(t, report.HasSeverity(SeverityError))
+
+	var findings []string
+	for _, f := range report.Findings {
+		findings = append(findings, string(f.Severity)+" "+f.Check+": "+f.Message)
+	}
+
+	expected := []string{
+		"info missing-apex-address: the apex example.com has no A, AAAA or ALIAS record",
+		"error dangling-cname: CNAME www targets web.example.com, which has no record",
+		"info low-ttl: txt _acme-challenge.www has a very low TTL (60)",
+		"warning acme-challenge: ACME challenge record _acme-challenge.www is probably a leftover",
+		"error multiple-spf: @ has 2 SPF records (only one is allowed)",
+		"warning spf: SPF record of @: the ptr mechanism is deprecated",
+		"warning spf: SPF record of @: it allows all the senders (+all)",
+	}
+
+	assert.Equal(t, expected, findings)
+}
+
+func Test_checkSPF(t *testing.T) {
+	assert.Empty(t, checkSPF("v=spf1 include:_spf.google.com -all"))
+	assert.Empty(t, checkSPF("v=spf1 redirect=_spf.example.com"))
+
+	assert.Equal(t, []string{"no all mechanism nor redirect modifier"}, checkSPF("v=spf1 mx"))
+
+	content := "v=spf1 a mx include:a include:b include:c include:d include:e include:f include:g include:h include:i -all"
+	assert.Equal(t, []string{"too many DNS lookups (11 > 10)"}, checkSPF(content))
+}
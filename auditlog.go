@@ -0,0 +1,69 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the actor of the mutations made with it (see WithAuditWriter).
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// AuditEntry is an entry of the audit trail (see WithAuditWriter).
+type AuditEntry struct {
+	ID        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	Actor     string    `json:"actor,omitempty"`
+	Operation Operation `json:"operation"`
+	ZoneID    string    `json:"zone_id"`
+	Zone      *Zone     `json:"zone,omitempty"`
+
+	// Before is the record before the mutation (OperationDeleteRecord).
+	Before *Record `json:"before,omitempty"`
+	// After is the record after the mutation (OperationCreateRecord).
+	After *Record `json:"after,omitempty"`
+}
+
+// WithAuditWriter writes an audit trail of the mutations performed by the client:
+// a JSON line (AuditEntry) per mutation.
+// The actor is read from the context of the call (see WithActor).
+// The writes are serialized, the write errors are ignored.
+// As with WithMutationHook, the deletion of a record requires an additional call to the API.
+func WithAuditWriter(w io.Writer) Option {
+	var mu sync.Mutex
+
+	return WithMutationHook(func(ctx context.Context, mutation Mutation) {
+		entry := AuditEntry{
+			ID:        mutation.ID,
+			Time:      mutation.Time,
+			Operation: mutation.Operation,
+			ZoneID:    mutation.ZoneID,
+			Zone:      mutation.Zone,
+		}
+
+		entry.Actor, _ = ctx.Value(actorKey{}).(string)
+
+		switch mutation.Operation {
+		case OperationCreateRecord:
+			entry.After = mutation.Record
+		case OperationDeleteRecord:
+			entry.Before = mutation.Record
+		}
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, _ = w.Write(append(line, '\n'))
+	})
+}
@@ -0,0 +1,51 @@
+package nodion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAuditWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	client, _ := setupFakeAPI(t, nil, WithAuditWriter(&buf), WithClock(&fixedClock{now: now}))
+
+	ctx := WithActor(context.Background(), "alice")
+
+	record, err := client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600})
+	require.NoError(t, err)
+
+	_, err = client.DeleteRecord(context.Background(), "zzz", record.ID)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var created, deleted AuditEntry
+
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &created))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &deleted))
+
+	assert.Equal(t, "alice", created.Actor)
+	assert.Equal(t, OperationCreateRecord, created.Operation)
+	assert.Equal(t, "zzz", created.ZoneID)
+	assert.True(t, now.Equal(created.Time))
+	assert.Nil(t, created.Before)
+	require.NotNil(t, created.After)
+	assert.Equal(t, "192.0.2.1", created.After.Content)
+
+	assert.Empty(t, deleted.Actor)
+	assert.Equal(t, OperationDeleteRecord, deleted.Operation)
+	assert.Nil(t, deleted.After)
+	require.NotNil(t, deleted.Before)
+	assert.Equal(t, record.ID, deleted.Before.ID)
+}
@@ -0,0 +1,47 @@
+package nodion
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authenticator authenticates the requests sent to the API.
+// By default, the API token is sent as a Bearer token.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc is a function implementing Authenticator.
+// It allows to implement custom schemes (e.g. HMAC-signed requests).
+type AuthenticatorFunc func(req *http.Request) error
+
+// Authenticate calls f(req).
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return f(req)
+}
+
+// BearerAuth sends a token in the Authorization header with the Bearer scheme.
+func BearerAuth(token string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return nil
+	})
+}
+
+// BasicAuth sends the credentials in the Authorization header with the Basic scheme.
+func BasicAuth(username, password string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// WithAuthenticator replaces the authentication scheme of the client.
+// The authenticator is called last, when the headers and the body of the request are final.
+// With an authenticator, the API token of NewClient can be empty.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(c *Client) error {
+		c.authenticator = authenticator
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package nodion
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAuthenticator_basic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "bot" || password != "pass" {
+			http.Error(rw, `{"errors":["unauthorized"]}`, http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = rw.Write([]byte(`{"dns_zones":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("", WithBaseURL(server.URL), WithAuthenticator(BasicAuth("bot", "pass")))
+	require.NoError(t, err)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+}
+
+func TestWithAuthenticator_func(t *testing.T) {
+	secret := []byte("key")
+
+	sign := AuthenticatorFunc(func(req *http.Request) error {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(req.Method + " " + req.URL.RequestURI()))
+
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+
+		return nil
+	})
+
+	client, mux := setupTestMux(t, WithAuthenticator(AuthenticatorFunc(func(req *http.Request) error {
+		// keeps the token expected by the test server.
+		err := BearerAuth("secret").Authenticate(req)
+		if err != nil {
+			return err
+		}
+
+		return sign(req)
+	})))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	requests := mux.Requests()
+	require.Len(t, requests, 1)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("GET /dns_zones"))
+
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), requests[0].Header.Get("X-Signature"))
+}
+
+func TestNewClient_tokenRequired(t *testing.T) {
+	_, err := NewClient("")
+	require.EqualError(t, err, "API token is required")
+}
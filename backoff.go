@@ -0,0 +1,81 @@
+package nodion
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Backoff computes the delays between the retries (see WithRetry and WithBackoff).
+type Backoff interface {
+	// NextDelay returns the delay before retrying a failed attempt (attempt starts at 0).
+	// err is the error of the attempt: an *APIError for the error responses (e.g. to apply per-status policies),
+	// or a network error.
+	// resp is the error response (e.g. to honor its Retry-After header), nil for a network error.
+	// The body of the response is already closed.
+	NextDelay(attempt int, err error, resp *http.Response) time.Duration
+}
+
+// BackoffFunc is a function implementing Backoff.
+type BackoffFunc func(attempt int, err error, resp *http.Response) time.Duration
+
+// NextDelay calls f(attempt, err, resp).
+func (f BackoffFunc) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	return f(attempt, err, resp)
+}
+
+// ExponentialBackoff doubles the delay after each attempt: base * 2^attempt, capped to maxDelay (0 means no cap).
+// It's the default backoff of WithRetry.
+func ExponentialBackoff(base, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(attempt int, _ error, _ *http.Response) time.Duration {
+		return exponentialDelay(base, maxDelay, attempt)
+	})
+}
+
+// FullJitterBackoff picks a random delay between 0 and the delay of ExponentialBackoff,
+// to spread the retries of concurrent clients.
+func FullJitterBackoff(base, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(attempt int, _ error, _ *http.Response) time.Duration {
+		delay := exponentialDelay(base, maxDelay, attempt)
+		if delay <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(delay) + 1))
+	})
+}
+
+func exponentialDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base << attempt
+
+	// the shift overflows for the large attempts.
+	if delay>>attempt != base || delay < 0 {
+		delay = math.MaxInt64
+	}
+
+	if maxDelay > 0 && delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
+
+// WithBackoff replaces the backoff of the retries (ExponentialBackoff by default).
+// It must be used after WithRetry.
+func WithBackoff(backoff Backoff) Option {
+	return func(c *Client) error {
+		if c.retrier == nil {
+			return errors.New("the backoff requires WithRetry")
+		}
+
+		if backoff == nil {
+			return errors.New("the backoff must not be nil")
+		}
+
+		c.retrier.backoff = backoff
+
+		return nil
+	}
+}
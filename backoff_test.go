@@ -0,0 +1,92 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion/nodiontest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(time.Second, 10*time.Second)
+
+	var delays []time.Duration
+	for attempt := 0; attempt < 5; attempt++ {
+		delays = append(delays, backoff.NextDelay(attempt, nil, nil))
+	}
+
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second}, delays)
+
+	assert.Equal(t, time.Duration(math.MaxInt64), ExponentialBackoff(time.Second, 0).NextDelay(100, nil, nil))
+}
+
+func TestFullJitterBackoff(t *testing.T) {
+	backoff := FullJitterBackoff(time.Second, 10*time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := backoff.NextDelay(attempt, nil, nil)
+
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, exponentialDelay(time.Second, 10*time.Second, attempt))
+	}
+}
+
+func TestWithBackoff(t *testing.T) {
+	var statuses []int
+
+	backoff := BackoffFunc(func(attempt int, err error, _ *http.Response) time.Duration {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			statuses = append(statuses, apiErr.StatusCode)
+		}
+
+		return time.Millisecond
+	})
+
+	client, server := setupTestMux(t, WithRetry(3, time.Hour), WithBackoff(backoff))
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	server.InjectFaults(http.MethodGet, "/dns_zones",
+		nodiontest.FaultRateLimited(""),
+		nodiontest.FaultStatus(http.StatusBadGateway),
+	)
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{http.StatusTooManyRequests, http.StatusBadGateway}, statuses)
+
+	_, err = NewClient("secret", WithBackoff(backoff))
+	require.EqualError(t, err, "the backoff requires WithRetry")
+}
+
+func TestWithBackoff_retryAfter(t *testing.T) {
+	var retryAfters []string
+
+	backoff := BackoffFunc(func(_ int, _ error, resp *http.Response) time.Duration {
+		if resp != nil {
+			retryAfters = append(retryAfters, resp.Header.Get("Retry-After"))
+		}
+
+		return time.Millisecond
+	})
+
+	client, server := setupTestMux(t, WithRetry(3, time.Hour), WithBackoff(backoff))
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	server.InjectFaults(http.MethodGet, "/dns_zones",
+		nodiontest.FaultRateLimited("7"),
+		nodiontest.FaultStatus(http.StatusBadGateway),
+	)
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"7", ""}, retryAfters)
+}
@@ -0,0 +1,144 @@
+package nodion
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when a request is rejected by the circuit breaker (see WithCircuitBreaker).
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of the circuit breaker.
+type BreakerState string
+
+// Circuit breaker states.
+const (
+	// BreakerClosed is the normal state: the requests are sent.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen is the state after consecutive failures: the requests are rejected with ErrCircuitOpen.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen is the state after the cooldown: a single probe request is sent,
+	// its success closes the circuit, its failure opens it again.
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// WithCircuitBreaker enables a circuit breaker:
+// after threshold consecutive failures (5xx responses or network errors), the requests are rejected with ErrCircuitOpen
+// during the cooldown, then a probe request decides if the circuit is closed again.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) error {
+		if threshold <= 0 {
+			return errors.New("the circuit breaker threshold must be positive")
+		}
+
+		if cooldown <= 0 {
+			return errors.New("the circuit breaker cooldown must be positive")
+		}
+
+		c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown, state: BreakerClosed}
+
+		return nil
+	}
+}
+
+// BreakerState returns the state of the circuit breaker (BreakerClosed if the circuit breaker is not enabled).
+// It's meant for the health endpoints.
+func (c Client) BreakerState() BreakerState {
+	if c.breaker == nil {
+		return BreakerClosed
+	}
+
+	c.breaker.mu.Lock()
+	defer c.breaker.mu.Unlock()
+
+	if c.breaker.state == BreakerOpen && !c.clock.Now().Before(c.breaker.openedAt.Add(c.breaker.cooldown)) {
+		return BreakerHalfOpen
+	}
+
+	return c.breaker.state
+}
+
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// allow reports whether a request can be sent.
+func (b *circuitBreaker) allow(clock Clock) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if clock.Now().Before(b.openedAt.Add(b.cooldown)) {
+			return ErrCircuitOpen
+		}
+
+		b.state = BreakerHalfOpen
+		b.probing = true
+
+		return nil
+
+	case BreakerHalfOpen:
+		if b.probing {
+			return fmt.Errorf("%w: probe in progress", ErrCircuitOpen)
+		}
+
+		b.probing = true
+
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// record records the result of a request (failed: 5xx response or network error).
+func (b *circuitBreaker) record(failed bool, clock Clock) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+
+	if !failed {
+		b.state = BreakerClosed
+		b.failures = 0
+
+		return
+	}
+
+	b.failures++
+
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state = BreakerOpen
+		b.openedAt = clock.Now()
+	}
+}
+
+// release releases the probe without result (the request has been canceled by the caller).
+func (b *circuitBreaker) release() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
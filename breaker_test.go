@@ -0,0 +1,79 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_circuitBreaker(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)}
+
+	client, mux := setupTestMux(t, WithCircuitBreaker(2, time.Minute), WithClock(clock))
+
+	var calls atomic.Int32
+
+	var healthy atomic.Bool
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		calls.Add(1)
+
+		if !healthy.Load() {
+			http.Error(rw, `{"status": 503, "error": "Service Unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetZones(context.Background(), nil)
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+	}
+
+	assert.Equal(t, BreakerOpen, client.BreakerState())
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.EqualValues(t, 2, calls.Load())
+
+	// the probe fails: the circuit is open again.
+	clock.now = clock.now.Add(time.Minute)
+	assert.Equal(t, BreakerHalfOpen, client.BreakerState())
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrCircuitOpen))
+
+	assert.Equal(t, BreakerOpen, client.BreakerState())
+
+	// the probe succeeds: the circuit is closed.
+	clock.now = clock.now.Add(time.Minute)
+	healthy.Store(true)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, BreakerClosed, client.BreakerState())
+	assert.EqualValues(t, 4, calls.Load())
+}
+
+func TestClient_circuitBreaker_clientErrors(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-error.json"),
+		WithCircuitBreaker(1, time.Minute))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetZones(context.Background(), nil)
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+	}
+
+	assert.Equal(t, BreakerClosed, client.BreakerState())
+}
@@ -0,0 +1,178 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultBulkConcurrency = 5
+
+// CreateZonesOptions are the options of CreateZones.
+type CreateZonesOptions struct {
+	// Zone are the options applied to each new zone (see CreateZoneWithOptions).
+	Zone CreateZoneOptions
+
+	// SkipExisting skips the zones that already exist, instead of reporting an error.
+	SkipExisting bool
+
+	// Concurrency is the maximum number of zones created in parallel (default: 5).
+	Concurrency int
+}
+
+// ZoneResult is the result of the operation on a zone of a bulk operation.
+type ZoneResult struct {
+	Name string
+	// Zone is the zone of the operation (for CreateZones: the new zone, or the existing zone if skipped).
+	Zone *Zone
+	// Skipped reports whether the zone was skipped.
+	Skipped bool
+	// Err is the error of the operation.
+	Err error
+}
+
+// CreateZones creates several zones with CreateZoneWithOptions.
+// A failure does not stop the creation of the other zones:
+// the results are returned in the order of the names, with the error of each zone.
+// The duplicated names are created only once (the next occurrences are skipped).
+// The returned error is only about the listing of the existing zones (SkipExisting).
+func (c Client) CreateZones(ctx context.Context, names []string, opts CreateZonesOptions) ([]ZoneResult, error) {
+	existing := map[string]Zone{}
+
+	if opts.SkipExisting {
+		zones, err := c.GetZones(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zone := range zones {
+			existing[normalizeHostname(zone.Name)] = zone
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make([]ZoneResult, len(names))
+
+	group := new(errgroup.Group)
+	group.SetLimit(concurrency)
+
+	seen := map[string]struct{}{}
+
+	for i, name := range names {
+		name = strings.TrimSuffix(strings.TrimSpace(name), ".")
+		results[i].Name = name
+
+		key := strings.ToLower(name)
+
+		if zone, ok := existing[key]; ok {
+			results[i].Zone = &zone
+			results[i].Skipped = true
+
+			continue
+		}
+
+		if _, ok := seen[key]; ok {
+			results[i].Skipped = true
+			continue
+		}
+
+		seen[key] = struct{}{}
+
+		result := &results[i]
+
+		group.Go(func() error {
+			result.Zone, result.Err = c.CreateZoneWithOptions(ctx, result.Name, opts.Zone)
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	return results, nil
+}
+
+// ZoneSelector selects zones by IDs or name patterns (see path.Match, e.g. `*.test.example.com`).
+// A zone is selected if it matches one of the IDs or one of the patterns: an empty selector selects nothing.
+type ZoneSelector struct {
+	IDs          []string
+	NamePatterns []string
+}
+
+func (s ZoneSelector) scope() (*zoneScope, error) {
+	scope := &zoneScope{ids: map[string]struct{}{}, patterns: s.NamePatterns}
+
+	for _, id := range s.IDs {
+		scope.ids[id] = struct{}{}
+	}
+
+	for _, pattern := range s.NamePatterns {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone name pattern %q: %w", pattern, err)
+		}
+	}
+
+	return scope, nil
+}
+
+// DeleteZonesOptions are the options of DeleteZones.
+type DeleteZonesOptions struct {
+	// Confirm is called before the deletion of each selected zone: the zone is skipped if it returns false.
+	// It is required.
+	Confirm func(zone Zone) bool
+
+	// DryRun only selects the zones: nothing is deleted and Confirm is not called.
+	DryRun bool
+}
+
+// DeleteZones deletes the zones matching a selector, one by one, after the confirmation of each zone.
+// The deletions respect WithZoneDeletionDisabled.
+// A failure does not stop the deletion of the other zones:
+// the results are returned in the order of the listing of the zones, with the error of each zone.
+// The returned error is only about the options and the listing of the zones.
+func (c Client) DeleteZones(ctx context.Context, selector ZoneSelector, opts DeleteZonesOptions) ([]ZoneResult, error) {
+	if opts.Confirm == nil {
+		return nil, errors.New("the deletion of the zones requires a confirmation callback")
+	}
+
+	scope, err := selector.scope()
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := c.GetZones(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ZoneResult
+
+	for _, zone := range zones {
+		if !scope.allowZone(zone) {
+			continue
+		}
+
+		zone := zone
+		result := ZoneResult{Name: zone.Name, Zone: &zone}
+
+		switch {
+		case opts.DryRun:
+		case !opts.Confirm(zone):
+			result.Skipped = true
+		default:
+			_, result.Err = c.DeleteZoneConfirmed(ctx, zone.ID, ZoneDeletionConfirmation{Confirm: true})
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
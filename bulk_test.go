@@ -0,0 +1,264 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeZonesAPI is an in-memory implementation of the zones API endpoints.
+type fakeZonesAPI struct {
+	mu    sync.Mutex
+	seq   int
+	zones map[string]Zone
+}
+
+func setupFakeZonesAPI(t *testing.T, names ...string) (*Client, *fakeZonesAPI) {
+	t.Helper()
+
+	api := &fakeZonesAPI{zones: map[string]Zone{}}
+	for _, name := range names {
+		api.add(name)
+	}
+
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(rw).Encode(ZonesResponse{Zones: api.list()})
+
+		case http.MethodPost:
+			var zone Zone
+
+			err := json.NewDecoder(req.Body).Decode(&zone)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if zone.Name == "invalid.com" {
+				rw.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = rw.Write([]byte(`{"status": 422, "error": "invalid zone"}`))
+
+				return
+			}
+
+			_ = json.NewEncoder(rw).Encode(ZoneResponse{Zone: api.add(zone.Name)})
+		}
+	})
+
+	mux.HandleFunc("/dns_zones/", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(DeleteResponse{Deleted: api.remove(path.Base(req.URL.Path))})
+	})
+
+	return client, api
+}
+
+func (f *fakeZonesAPI) remove(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.zones[id]
+	delete(f.zones, id)
+
+	return ok
+}
+
+func (f *fakeZonesAPI) add(name string) Zone {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.seq++
+	zone := Zone{ID: fmt.Sprintf("zone%d", f.seq), Name: name}
+	f.zones[zone.ID] = zone
+
+	return zone
+}
+
+func (f *fakeZonesAPI) list() []Zone {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	zones := []Zone{}
+	for _, zone := range f.zones {
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Name < zones[j].Name })
+
+	return zones
+}
+
+func (f *fakeZonesAPI) names() []string {
+	var names []string
+	for _, zone := range f.list() {
+		names = append(names, zone.Name)
+	}
+
+	return names
+}
+
+func TestClient_CreateZones(t *testing.T) {
+	client, api := setupFakeZonesAPI(t, "example.com")
+
+	names := []string{"example.org", "example.com.", "invalid.com", "example.net", "EXAMPLE.org"}
+
+	results, err := client.CreateZones(context.Background(), names, CreateZonesOptions{SkipExisting: true, Concurrency: 2})
+	require.NoError(t, err)
+
+	require.Len(t, results, len(names))
+
+	assert.Equal(t, "example.org", results[0].Name)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, "example.org", results[0].Zone.Name)
+	assert.False(t, results[0].Skipped)
+
+	assert.Equal(t, "example.com", results[1].Name)
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, "zone1", results[1].Zone.ID)
+	assert.True(t, results[1].Skipped)
+
+	assert.Equal(t, "invalid.com", results[2].Name)
+	require.EqualError(t, results[2].Err, "status code 422: invalid zone")
+	assert.Nil(t, results[2].Zone)
+
+	require.NoError(t, results[3].Err)
+	assert.Equal(t, "example.net", results[3].Zone.Name)
+
+	assert.True(t, results[4].Skipped)
+	assert.Nil(t, results[4].Zone)
+
+	assert.Equal(t, []string{"example.com", "example.net", "example.org"}, api.names())
+}
+
+func TestClient_CreateZones_existing(t *testing.T) {
+	client, api := setupFakeZonesAPI(t, "example.com")
+
+	results, err := client.CreateZones(context.Background(), []string{"example.com"}, CreateZonesOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	assert.False(t, results[0].Skipped)
+
+	// the fake API accepts the duplicated zones.
+	assert.Equal(t, []string{"example.com", "example.com"}, api.names())
+}
+
+func TestClient_DeleteZones(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		selector ZoneSelector
+		dryRun   bool
+		results  []ZoneResult
+		expected []string
+	}{
+		{
+			desc:     "name patterns",
+			selector: ZoneSelector{NamePatterns: []string{"*.test.com", "expired-*.com"}},
+			results: []ZoneResult{
+				{Name: "a.test.com"},
+				{Name: "b.test.com", Skipped: true},
+				{Name: "expired-1.com"},
+			},
+			expected: []string{"b.test.com", "example.com"},
+		},
+		{
+			desc:     "IDs",
+			selector: ZoneSelector{IDs: []string{"zone2", "zone4"}},
+			results: []ZoneResult{
+				{Name: "b.test.com", Skipped: true},
+				{Name: "expired-1.com"},
+			},
+			expected: []string{"a.test.com", "b.test.com", "example.com"},
+		},
+		{
+			desc:     "dry-run",
+			selector: ZoneSelector{NamePatterns: []string{"*.test.com"}},
+			dryRun:   true,
+			results: []ZoneResult{
+				{Name: "a.test.com"},
+				{Name: "b.test.com"},
+			},
+			expected: []string{"a.test.com", "b.test.com", "example.com", "expired-1.com"},
+		},
+		{
+			desc:     "empty selector",
+			expected: []string{"a.test.com", "b.test.com", "example.com", "expired-1.com"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, api := setupFakeZonesAPI(t, "a.test.com", "b.test.com", "example.com", "expired-1.com")
+
+			var confirmed []string
+
+			opts := DeleteZonesOptions{
+				Confirm: func(zone Zone) bool {
+					confirmed = append(confirmed, zone.Name)
+					return zone.Name != "b.test.com"
+				},
+				DryRun: test.dryRun,
+			}
+
+			results, err := client.DeleteZones(context.Background(), test.selector, opts)
+			require.NoError(t, err)
+
+			var summary []ZoneResult
+			for _, result := range results {
+				require.NoError(t, result.Err)
+				summary = append(summary, ZoneResult{Name: result.Name, Skipped: result.Skipped})
+			}
+
+			assert.Equal(t, test.results, summary)
+			assert.Equal(t, test.expected, api.names())
+
+			if test.dryRun {
+				assert.Empty(t, confirmed)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteZones_disabled(t *testing.T) {
+	client, api := setupFakeZonesAPI(t, "a.test.com")
+
+	client.deletionGuard = deletionGuardDisabled
+
+	results, err := client.DeleteZones(context.Background(), ZoneSelector{IDs: []string{"zone1"}}, DeleteZonesOptions{
+		Confirm: func(Zone) bool { return true },
+	})
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err, ErrZoneDeletionDisabled)
+
+	assert.Equal(t, []string{"a.test.com"}, api.names())
+}
+
+func TestClient_DeleteZones_noConfirmation(t *testing.T) {
+	client, api := setupFakeZonesAPI(t, "a.test.com")
+
+	_, err := client.DeleteZones(context.Background(), ZoneSelector{IDs: []string{"zone1"}}, DeleteZonesOptions{})
+	require.EqualError(t, err, "the deletion of the zones requires a confirmation callback")
+
+	assert.Equal(t, []string{"a.test.com"}, api.names())
+}
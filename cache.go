@@ -0,0 +1,152 @@
+package nodion
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// WithCache enables an in-memory cache of the responses of GetZones and GetRecords.
+// The entries expire after ttl,
+// and they are invalidated by any mutation (create/delete) performed through the same client.
+func WithCache(ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return errors.New("cache TTL must be positive")
+		}
+
+		c.cache = newResponseCache(ttl)
+
+		return nil
+	}
+}
+
+// WithStaleCache keeps the expired entries of the cache (see WithCache) for maxStale,
+// and uses them when the API is unavailable (network errors, 5xx responses, circuit breaker open).
+// The stale responses are flagged in the CallInfo of the context (see WithCallInfo).
+// It must be used after WithCache.
+func WithStaleCache(maxStale time.Duration) Option {
+	return func(c *Client) error {
+		if c.cache == nil {
+			return errors.New("the stale cache requires WithCache")
+		}
+
+		if maxStale <= 0 {
+			return errors.New("max stale duration must be positive")
+		}
+
+		c.cache.maxStale = maxStale
+
+		return nil
+	}
+}
+
+type cacheEntry struct {
+	raw       []byte
+	zoneID    string
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+// responseCache stores raw response bodies keyed by request URL.
+// A nil responseCache is a valid disabled cache.
+type responseCache struct {
+	ttl      time.Duration
+	maxStale time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get returns a fresh entry and its age.
+func (r *responseCache) get(key string, clock Clock) ([]byte, time.Duration, bool) {
+	if r == nil {
+		return nil, 0, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+
+	now := clock.Now()
+
+	if now.After(entry.expiresAt) {
+		if now.After(entry.expiresAt.Add(r.maxStale)) {
+			delete(r.entries, key)
+		}
+
+		return nil, 0, false
+	}
+
+	return entry.raw, now.Sub(entry.storedAt), true
+}
+
+// getStale returns an entry, expired or not (within the max stale duration), and its age.
+func (r *responseCache) getStale(key string, clock Clock) ([]byte, time.Duration, bool) {
+	if r == nil || r.maxStale == 0 {
+		return nil, 0, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+
+	now := clock.Now()
+
+	if now.After(entry.expiresAt.Add(r.maxStale)) {
+		delete(r.entries, key)
+		return nil, 0, false
+	}
+
+	return entry.raw, now.Sub(entry.storedAt), true
+}
+
+func (r *responseCache) set(key, zoneID string, raw []byte, clock Clock) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := clock.Now()
+
+	r.entries[key] = cacheEntry{
+		raw:       raw,
+		zoneID:    zoneID,
+		storedAt:  now,
+		expiresAt: now.Add(r.ttl),
+	}
+}
+
+// invalidate removes the zone lists (they embed the records)
+// and the entries related to the zone.
+func (r *responseCache) invalidate(zoneID string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, entry := range r.entries {
+		if entry.zoneID == "" || entry.zoneID == zoneID {
+			delete(r.entries, key)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingHandler(counter *atomic.Int32, next http.HandlerFunc) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		counter.Add(1)
+		next(rw, req)
+	}
+}
+
+func TestClient_GetRecords_cache(t *testing.T) {
+	var counter atomic.Int32
+
+	client := setupTest(t, "/dns_zones/xxx/records",
+		countingHandler(&counter, readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")),
+		WithCache(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		records, err := client.GetRecords(context.Background(), "xxx", nil)
+		require.NoError(t, err)
+
+		require.Len(t, records, 5)
+	}
+
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestResponseCache_invalidate(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+
+	clock := &fixedClock{now: time.Now()}
+
+	cache.set("zones", "", []byte("a"), clock)
+	cache.set("records-xxx", "xxx", []byte("b"), clock)
+	cache.set("records-yyy", "yyy", []byte("c"), clock)
+
+	cache.invalidate("xxx")
+
+	_, _, ok := cache.get("zones", clock)
+	assert.False(t, ok)
+
+	_, _, ok = cache.get("records-xxx", clock)
+	assert.False(t, ok)
+
+	_, _, ok = cache.get("records-yyy", clock)
+	assert.True(t, ok)
+
+	clock.now = clock.now.Add(2 * time.Minute)
+
+	_, _, ok = cache.get("records-yyy", clock)
+	assert.False(t, ok)
+}
+
+func TestWithCache_invalidTTL(t *testing.T) {
+	_, err := NewClient("secret", WithCache(0))
+	require.Error(t, err)
+}
+
+func TestClient_GetZones_deduplication(t *testing.T) {
+	var counter atomic.Int32
+
+	release := make(chan struct{})
+
+	client := setupTest(t, "/dns_zones",
+		countingHandler(&counter, func(rw http.ResponseWriter, req *http.Request) {
+			<-release
+			readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+		}))
+
+	const n = 10
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.GetZones(context.Background(), nil)
+			errs <- err
+		}()
+	}
+
+	// lets the goroutines join the in-flight request.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestClient_GetZones_staleCache(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)}
+
+	var down atomic.Bool
+
+	client := setupTest(t, "/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		if down.Load() {
+			http.Error(rw, `{"status": 502, "error": "Bad Gateway"}`, http.StatusBadGateway)
+			return
+		}
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	}, WithCache(time.Minute), WithStaleCache(time.Hour), WithClock(clock))
+
+	info := &CallInfo{}
+	ctx := WithCallInfo(context.Background(), info)
+
+	_, err := client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	assert.False(t, info.Cached)
+	assert.Equal(t, "/dns_zones", info.Timings.Endpoint)
+
+	clock.now = clock.now.Add(30 * time.Second)
+
+	_, err = client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, CallInfo{Cached: true, Age: 30 * time.Second}, *info)
+
+	down.Store(true)
+	clock.now = clock.now.Add(10 * time.Minute)
+
+	zones, err := client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	require.Len(t, zones, 1)
+	assert.Equal(t, CallInfo{Cached: true, Stale: true, Age: 10*time.Minute + 30*time.Second}, *info)
+
+	clock.now = clock.now.Add(time.Hour)
+
+	_, err = client.GetZones(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestClient_GetZones_callInfo_cachedThenLive(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)}
+
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"),
+		WithCache(time.Minute), WithClock(clock))
+
+	info := &CallInfo{}
+	ctx := WithCallInfo(context.Background(), info)
+
+	_, err := client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	clock.now = clock.now.Add(30 * time.Second)
+
+	_, err = client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, CallInfo{Cached: true, Age: 30 * time.Second}, *info)
+
+	clock.now = clock.now.Add(time.Minute)
+
+	_, err = client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	assert.False(t, info.Cached)
+	assert.False(t, info.Stale)
+	assert.Zero(t, info.Age)
+	assert.Equal(t, "/dns_zones", info.Timings.Endpoint)
+}
+
+func TestClient_GetZones_staleCache_clientError(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)}
+
+	var notFound atomic.Bool
+
+	client := setupTest(t, "/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		if notFound.Load() {
+			readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-error.json")(rw, req)
+			return
+		}
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	}, WithCache(time.Minute), WithStaleCache(time.Hour), WithClock(clock))
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	notFound.Store(true)
+	clock.now = clock.now.Add(10 * time.Minute)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestWithStaleCache_withoutCache(t *testing.T) {
+	_, err := NewClient("secret", WithStaleCache(time.Hour))
+	require.EqualError(t, err, "the stale cache requires WithCache")
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
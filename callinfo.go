@@ -0,0 +1,69 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+type callInfoKey struct{}
+
+// CallInfo is the information about a call, filled by the client (see WithCallInfo).
+type CallInfo struct {
+	// Cached reports whether the response comes from the cache (see WithCache).
+	Cached bool
+	// Stale reports whether the response is an expired cached response used during an outage (see WithStaleCache).
+	Stale bool
+	// Age is the age of the cached response.
+	Age time.Duration
+	// Timings is the timing breakdown of the HTTP request (empty for a cached response).
+	Timings Timings
+}
+
+// WithCallInfo returns a context that collects the information about the calls made with it into info.
+// The info is overwritten by each call: a context must not be shared by concurrent calls.
+func WithCallInfo(ctx context.Context, info *CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// resetCallInfo clears the information about the previous call.
+func resetCallInfo(ctx context.Context) {
+	info, ok := ctx.Value(callInfoKey{}).(*CallInfo)
+	if !ok || info == nil {
+		return
+	}
+
+	*info = CallInfo{}
+}
+
+func setCallInfo(ctx context.Context, stale bool, age time.Duration) {
+	info, ok := ctx.Value(callInfoKey{}).(*CallInfo)
+	if !ok || info == nil {
+		return
+	}
+
+	info.Cached = true
+	info.Stale = stale
+	info.Age = age
+	info.Timings = Timings{}
+}
+
+// isOutage reports whether an error is caused by the unavailability of the API
+// (and not by the caller or by the request).
+func isOutage(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
@@ -0,0 +1,193 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type changeKind int
+
+const (
+	changeAdd changeKind = iota + 1
+	changeDelete
+)
+
+type change struct {
+	kind   changeKind
+	record Record
+}
+
+// ChangeSet is a set of changes applied to the records of a zone.
+// The changes are applied in order by Commit,
+// and the changes already applied are rolled back (with compensating operations) if one of them fails.
+//
+// The Nodion API has no update endpoint: an update is applied as a creation followed by a deletion
+// (a deletion followed by a creation for the CNAME records, see Update).
+type ChangeSet struct {
+	client  Client
+	zoneID  string
+	changes []change
+}
+
+// BeginChangeSet creates a new ChangeSet for a zone.
+func (c Client) BeginChangeSet(zoneID string) *ChangeSet {
+	return &ChangeSet{client: c, zoneID: zoneID}
+}
+
+// Add queues the creation of a record.
+func (s *ChangeSet) Add(record Record) *ChangeSet {
+	s.changes = append(s.changes, change{kind: changeAdd, record: record})
+	return s
+}
+
+// Update queues the replacement of an existing record (with an ID) by a new record.
+// The new record is created before the deletion of the existing record,
+// except for the CNAME records: a CNAME record can't coexist with another record with the same name,
+// so the existing record is deleted first (and recreated by the rollback if the creation fails).
+func (s *ChangeSet) Update(current, desired Record) *ChangeSet {
+	if isCNAME(current) || isCNAME(desired) {
+		return s.Delete(current).Add(desired)
+	}
+
+	return s.Add(desired).Delete(current)
+}
+
+// Delete queues the deletion of an existing record (with an ID).
+// The complete record is required to be able to recreate it during a rollback.
+func (s *ChangeSet) Delete(record Record) *ChangeSet {
+	s.changes = append(s.changes, change{kind: changeDelete, record: record})
+	return s
+}
+
+// Len returns the number of queued changes.
+func (s *ChangeSet) Len() int {
+	return len(s.changes)
+}
+
+// Commit applies the changes.
+// It returns the created records.
+// In case of error, the applied changes are rolled back, and the error is a *ChangeSetError.
+func (s *ChangeSet) Commit(ctx context.Context) ([]Record, error) {
+	ctx, unlock := s.client.lockZone(ctx, s.zoneID)
+	defer unlock()
+
+	var applied []change
+
+	var created []Record
+
+	for _, ch := range s.changes {
+		result, err := s.apply(ctx, ch)
+		if err != nil {
+			return nil, &ChangeSetError{
+				Err:          fmt.Errorf("%s: %w", ch, err),
+				RollbackErrs: s.rollback(ctx, applied),
+			}
+		}
+
+		applied = append(applied, result)
+
+		if result.kind == changeAdd {
+			created = append(created, result.record)
+		}
+	}
+
+	return created, nil
+}
+
+// apply applies a change, and returns the change as applied (with the ID of the created record).
+func (s *ChangeSet) apply(ctx context.Context, ch change) (change, error) {
+	switch ch.kind {
+	case changeAdd:
+		newRecord, err := s.client.CreateRecord(ctx, s.zoneID, ch.record)
+		if err != nil {
+			return ch, err
+		}
+
+		return change{kind: changeAdd, record: *newRecord}, nil
+
+	case changeDelete:
+		if ch.record.ID == "" {
+			return ch, errors.New("missing record ID")
+		}
+
+		_, err := s.client.DeleteRecord(ctx, s.zoneID, ch.record.ID)
+
+		return ch, err
+
+	default:
+		return ch, fmt.Errorf("unknown change kind: %d", ch.kind)
+	}
+}
+
+// rollback applies the inverse of the applied changes, in reverse order.
+func (s *ChangeSet) rollback(ctx context.Context, applied []change) []error {
+	var errs []error
+
+	for i := len(applied) - 1; i >= 0; i-- {
+		ch := applied[i]
+
+		var inverse change
+
+		switch ch.kind {
+		case changeAdd:
+			inverse = change{kind: changeDelete, record: ch.record}
+		case changeDelete:
+			inverse = change{kind: changeAdd, record: Record{
+				RecordType: ch.record.RecordType,
+				Name:       ch.record.Name,
+				Content:    ch.record.Content,
+				TTL:        ch.record.TTL,
+				Tags:       ch.record.Tags,
+			}}
+		}
+
+		_, err := s.apply(ctx, inverse)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rollback %s: %w", inverse, err))
+		}
+	}
+
+	return errs
+}
+
+func (c change) String() string {
+	switch c.kind {
+	case changeAdd:
+		return fmt.Sprintf("add record %s (%s)", c.record.Name, c.record.RecordType)
+	case changeDelete:
+		return fmt.Sprintf("delete record %s (%s) [%s]", c.record.Name, c.record.RecordType, c.record.ID)
+	default:
+		return "unknown change"
+	}
+}
+
+// ChangeSetError is the error returned when a ChangeSet fails to commit.
+type ChangeSetError struct {
+	// Err is the error of the change that failed.
+	Err error
+	// RollbackErrs are the errors that occurred during the rollback.
+	RollbackErrs []error
+}
+
+func (e *ChangeSetError) Error() string {
+	if len(e.RollbackErrs) == 0 {
+		return fmt.Sprintf("change set: %v (rolled back)", e.Err)
+	}
+
+	var msgs []string
+	for _, err := range e.RollbackErrs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("change set: %v (rollback failed: %s)", e.Err, strings.Join(msgs, ", "))
+}
+
+func (e *ChangeSetError) Unwrap() error {
+	return e.Err
+}
+
+func isCNAME(record Record) bool {
+	return record.RecordType.normalized() == TypeCNAME
+}
@@ -0,0 +1,122 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeSet_Commit(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones/xxx/records", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json"))
+	mux.HandleFunc("/dns_zones/xxx/records/aaa", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	created, err := client.BeginChangeSet("xxx").
+		Update(
+			Record{ID: "aaa", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+			Record{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60},
+		).
+		Commit(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, created, 1)
+	assert.Equal(t, "748d688a-3004-4b84-b8b8-8cb2e07c5c71", created[0].ID)
+}
+
+func TestChangeSet_Commit_rollback(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	var mu sync.Mutex
+
+	var calls []string
+
+	record := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(rw http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			calls = append(calls, req.Method+" "+req.URL.Path)
+			mu.Unlock()
+
+			next(rw, req)
+		}
+	}
+
+	mux.HandleFunc("/dns_zones/xxx/records", record(readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")))
+	mux.HandleFunc("/dns_zones/xxx/records/748d688a-3004-4b84-b8b8-8cb2e07c5c71", record(readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json")))
+	mux.HandleFunc("/dns_zones/xxx/records/aaa", record(readFileHandler(http.MethodDelete, http.StatusNotFound, "delete-dns-zone-record-error.json")))
+
+	_, err := client.BeginChangeSet("xxx").
+		Add(Record{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60}).
+		Delete(Record{ID: "aaa", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}).
+		Commit(context.Background())
+	require.Error(t, err)
+
+	var csErr *ChangeSetError
+	require.ErrorAs(t, err, &csErr)
+	assert.Empty(t, csErr.RollbackErrs)
+
+	expected := []string{
+		"POST /dns_zones/xxx/records",
+		"DELETE /dns_zones/xxx/records/aaa",
+		"DELETE /dns_zones/xxx/records/748d688a-3004-4b84-b8b8-8cb2e07c5c71",
+	}
+
+	assert.Equal(t, expected, calls)
+}
+
+func TestChangeSet_Update_cname(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "example.org", TTL: 3600},
+	}, WithCNAMEConflictCheck())
+
+	current := api.sorted()[0]
+
+	created, err := client.BeginChangeSet("zzz").
+		Update(current, Record{RecordType: TypeCNAME, Name: "www", Content: "example.net", TTL: 60}).
+		Commit(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, created, 1)
+	assert.Equal(t, []string{"www cname example.net"}, api.snapshot())
+
+	// a CNAME replaced by an A record.
+	_, err = client.BeginChangeSet("zzz").
+		Update(created[0], Record{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}).
+		Commit(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www a 1.1.1.1"}, api.snapshot())
+}
+
+func TestChangeSet_Commit_rollbackTags(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		{RecordType: TypeA, Name: "api", Content: "1.1.1.1", TTL: 60},
+	}, WithTagStore(NewMemoryTagStore()), WithCNAMEConflictCheck())
+
+	ctx := context.Background()
+
+	err := client.SetRecordTags(ctx, "id1", map[string]string{"owner": "me"})
+	require.NoError(t, err)
+
+	records, err := client.GetRecords(ctx, "zzz", &RecordsFilter{Name: "www"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	_, err = client.BeginChangeSet("zzz").
+		Delete(records[0]).
+		Add(Record{RecordType: TypeCNAME, Name: "api", Content: "example.org", TTL: 60}).
+		Commit(ctx)
+	require.ErrorIs(t, err, ErrRecordConflict)
+
+	assert.Equal(t, []string{"api a 1.1.1.1", "www a 1.1.1.1"}, api.snapshot())
+
+	restored, err := client.GetRecords(ctx, "zzz", &RecordsFilter{Tags: map[string]string{"owner": "me"}})
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+	assert.Equal(t, "www", restored[0].Name)
+}
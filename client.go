@@ -8,49 +8,186 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	querystring "github.com/google/go-querystring/query"
+	"golang.org/x/sync/singleflight"
 )
 
-const defaultBaseURL = "https://api.nodion.com/v1/"
+const (
+	defaultBaseURL   = "https://api.nodion.com/v1/"
+	defaultUserAgent = "nrdcg-nodion"
+	defaultTimeout   = 5 * time.Second
+)
 
 // Client the Nodion API client.
+//
+// A Client is safe for concurrent use by multiple goroutines:
+// its mutable state (cache, recycle bin, rate limit, circuit breaker, retry budget, ...) is synchronized internally.
+// The HTTPClient field must not be modified while the client is in use.
+// The values provided through the options (TagStore, ProfileStore, MutationHook, Clock, IDGenerator, JSONCodec, Authenticator, ...)
+// must be safe for concurrent use too.
 type Client struct {
-	HTTPClient *http.Client
-	baseURL    *url.URL
-	apiToken   string
+	HTTPClient    *http.Client
+	baseURL       *url.URL
+	authenticator Authenticator
+
+	cache  *responseCache
+	flight *singleflight.Group
+
+	deletionGuard deletionGuardMode
+	recycleBin    *recycleBin
+	mutationHooks []MutationHook
+	tagStore      TagStore
+	profileStore  ProfileStore
+	registry      *ownershipRegistry
+
+	lookupNS func(ctx context.Context, name string) ([]*net.NS, error)
+
+	defaultTimeout     time.Duration
+	requestCompression bool
+	codec              JSONCodec
+	userAgent          string
+	requestEditors     []requestEditor
+
+	clock       Clock
+	idGenerator IDGenerator
+
+	rateLimit *rateLimitState
+	breaker   *circuitBreaker
+	retrier   *retrier
+	zoneLocks *zoneLocks
+	ttlPolicy *ttlPolicy
+
+	recordValidators   []RecordValidator
+	cnameConflictCheck bool
+
+	correlation *correlation
+	logger      *slog.Logger
+	readOnly    bool
+	scope       *zoneScope
+
+	freezes      *zoneFreezes
+	freezeMarker bool
+
+	deprecations  *deprecationState
+	drift         *driftDetector
+	timingHandler TimingHandler
 }
 
+// Option configures a Client.
+type Option func(*Client) error
+
 // NewClient creates a new Client.
-func NewClient(apiToken string) (*Client, error) {
+func NewClient(apiToken string, opts ...Option) (*Client, error) {
 	baseURL, err := url.Parse(defaultBaseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	if apiToken == "" {
-		return nil, errors.New("API token is required")
+	client := &Client{
+		HTTPClient:     &http.Client{},
+		baseURL:        baseURL,
+		defaultTimeout: defaultTimeout,
+		flight:         &singleflight.Group{},
+		lookupNS:       net.DefaultResolver.LookupNS,
+		codec:          stdJSONCodec{},
+		userAgent:      defaultUserAgent,
+		clock:          systemClock{},
+		idGenerator:    randomIDGenerator{},
+		rateLimit:      &rateLimitState{},
+		deprecations:   newDeprecationState(),
+		freezes:        newZoneFreezes(),
+	}
+
+	for _, opt := range opts {
+		err = opt(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if client.authenticator == nil {
+		if apiToken == "" {
+			return nil, errors.New("API token is required")
+		}
+
+		client.authenticator = BearerAuth(apiToken)
+	}
+
+	return client, nil
+}
+
+// WithBaseURL overrides the base URL of the API.
+func WithBaseURL(rawURL string) Option {
+	return func(c *Client) error {
+		baseURL, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("parse base URL: %w", err)
+		}
+
+		c.baseURL = baseURL
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// NewRequest creates a request for an endpoint of the API.
+// The path (and its optional query) is relative to the base URL (ex: `dns_zones/<zoneID>/records?name=www`),
+// the body (if not nil) is encoded to JSON.
+// The request can be sent with Do.
+func (c Client) NewRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("parse path: %w", err)
+	}
+
+	endpoint := c.baseURL.JoinPath(rel.Path)
+	endpoint.RawQuery = rel.RawQuery
+
+	var reqBody io.Reader = http.NoBody
+
+	if body != nil {
+		reqBody, err = c.encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	return &Client{
-		HTTPClient: &http.Client{Timeout: 5 * time.Second},
-		baseURL:    baseURL,
-		apiToken:   apiToken,
-	}, nil
+	return req, nil
 }
 
 // CreateZone To create a new DNS Zone.
 // https://www.nodion.com/en/docs/dns/api/#post-dns-zone
 func (c Client) CreateZone(ctx context.Context, name string) (*Zone, error) {
+	err := c.checkZoneName(name)
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := c.baseURL.JoinPath("dns_zones")
 
-	body := &bytes.Buffer{}
-	err := json.NewEncoder(body).Encode(Zone{Name: name})
+	body, err := c.encode(Zone{Name: name})
 	if err != nil {
-		return nil, fmt.Errorf("encode request body: %w", err)
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), body)
@@ -58,18 +195,33 @@ func (c Client) CreateZone(ctx context.Context, name string) (*Zone, error) {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	var result ZoneResponse
-	err = c.do(req, &result)
+	result, err := do[ZoneResponse](c, req)
 	if err != nil {
 		return nil, err
 	}
 
+	c.notify(ctx, Mutation{Operation: OperationCreateZone, ZoneID: result.Zone.ID, Zone: &result.Zone})
+
 	return &result.Zone, nil
 }
 
 // DeleteZone To delete an existing DNS Zone.
 // https://www.nodion.com/en/docs/dns/api/#delete-dns-zone
 func (c Client) DeleteZone(ctx context.Context, zoneID string) (bool, error) {
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	switch c.deletionGuard {
+	case deletionGuardConfirm:
+		return false, fmt.Errorf("%w: use DeleteZoneConfirmed", ErrDeletionNotConfirmed)
+	case deletionGuardDisabled:
+		return false, ErrZoneDeletionDisabled
+	default:
+		return c.deleteZone(ctx, zoneID)
+	}
+}
+
+func (c Client) deleteZone(ctx context.Context, zoneID string) (bool, error) {
 	endpoint := c.baseURL.JoinPath("dns_zones", zoneID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint.String(), http.NoBody)
@@ -77,20 +229,54 @@ func (c Client) DeleteZone(ctx context.Context, zoneID string) (bool, error) {
 		return false, fmt.Errorf("create request: %w", err)
 	}
 
-	var result DeleteResponse
-	err = c.do(req, &result)
+	result, err := do[DeleteResponse](c, req)
 	if err != nil {
 		return false, err
 	}
 
+	if result.Deleted {
+		c.notify(ctx, Mutation{Operation: OperationDeleteZone, ZoneID: zoneID})
+	}
+
 	return result.Deleted, nil
 }
 
 // GetZones To list all existing DNS zones.
 // https://www.nodion.com/en/docs/dns/api/#get-dns-zones
 func (c Client) GetZones(ctx context.Context, filter *ZonesFilter) ([]Zone, error) {
+	req, err := c.newZonesRequest(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := do[ZonesResponse](c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Zones {
+		decodeRecords(result.Zones[i].Records)
+	}
+
+	zones := c.scope.filterZones(result.Zones)
+
+	if filter != nil {
+		sortZones(zones, filter.SortBy, filter.Descending)
+	}
+
+	return zones, nil
+}
+
+func (c Client) newZonesRequest(ctx context.Context, filter *ZonesFilter) (*http.Request, error) {
 	endpoint := c.baseURL.JoinPath("dns_zones")
 
+	if filter != nil {
+		err := validateSort(filter.SortBy, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	values, err := querystring.Values(filter)
 	if err != nil {
 		return nil, fmt.Errorf("create zones filter: %w", err)
@@ -103,24 +289,83 @@ func (c Client) GetZones(ctx context.Context, filter *ZonesFilter) ([]Zone, erro
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	var result ZonesResponse
-	err = c.do(req, &result)
+	return req, nil
+}
+
+// CreateRecord To create a new Record for a DNS zone.
+// https://www.nodion.com/en/docs/dns/api/#post-dns-record
+func (c Client) CreateRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	// checked before the validations: they can call the API.
+	err := c.checkMethod(http.MethodPost)
 	if err != nil {
 		return nil, err
 	}
 
-	return result.Zones, nil
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	ttl, err := c.ttlPolicy.apply(record.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	record.TTL = ttl
+	record.RecordType = record.RecordType.normalized()
+
+	err = c.validateRecord(ctx, OperationCreateRecord, zoneID, record)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkCNAMEConflict(ctx, zoneID, record)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.registry != nil && !isOwnershipRecord(record) {
+		return c.createOwnedRecord(ctx, zoneID, record)
+	}
+
+	return c.createRecord(ctx, zoneID, record)
 }
 
-// CreateRecord To create a new Record for a DNS zone.
-// https://www.nodion.com/en/docs/dns/api/#post-dns-record
-func (c Client) CreateRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+func (c Client) createRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	var (
+		newRecord *Record
+		err       error
+	)
+
+	if c.retrier != nil && c.retrier.reconcileCreates {
+		newRecord, err = c.postRecordReconciled(ctx, zoneID, record)
+	} else {
+		newRecord, err = c.postRecord(ctx, zoneID, record)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.tagStore != nil && len(record.Tags) > 0 {
+		err = c.tagStore.SetTags(ctx, newRecord.ID, record.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("set tags of the record %s: %w", newRecord.ID, err)
+		}
+
+		newRecord.Tags = record.Tags
+	}
+
+	c.notify(ctx, Mutation{Operation: OperationCreateRecord, ZoneID: zoneID, Record: newRecord})
+
+	return newRecord, nil
+}
+
+// postRecord sends the creation of a record to the API.
+func (c Client) postRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
 	endpoint := c.baseURL.JoinPath("dns_zones", zoneID, "records")
 
-	body := &bytes.Buffer{}
-	err := json.NewEncoder(body).Encode(record)
+	body, err := c.encode(encodeRecord(record))
 	if err != nil {
-		return nil, fmt.Errorf("encode request body: %w", err)
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), body)
@@ -128,18 +373,52 @@ func (c Client) CreateRecord(ctx context.Context, zoneID string, record Record)
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	var result RecordResponse
-	err = c.do(req, &result)
+	result, err := do[RecordResponse](c, req)
 	if err != nil {
 		return nil, err
 	}
 
+	result.Record = decodeRecords([]Record{result.Record})[0]
+
 	return &result.Record, nil
 }
 
 // DeleteRecord To delete an existing Record for a DNS zone.
 // https://www.nodion.com/en/docs/dns/api/#delete-dns-record
 func (c Client) DeleteRecord(ctx context.Context, zoneID, recordID string) (bool, error) {
+	// checked before the snapshot of the record.
+	err := c.checkMethod(http.MethodDelete)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	var snapshot *Record
+
+	if c.recycleBin != nil || len(c.mutationHooks) > 0 || c.registry != nil || len(c.recordValidators) > 0 {
+		snapshot, err = c.findRecord(ctx, zoneID, recordID)
+		if err != nil {
+			return false, fmt.Errorf("snapshot record: %w", err)
+		}
+
+		err = c.validateRecord(ctx, OperationDeleteRecord, zoneID, *snapshot)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if c.registry != nil && !isOwnershipRecord(*snapshot) {
+		return c.deleteOwnedRecord(ctx, zoneID, *snapshot)
+	}
+
+	return c.deleteRecord(ctx, zoneID, recordID, snapshot)
+}
+
+// deleteRecord deletes a record.
+// The snapshot is the record before its deletion, it's required by the recycle bin and the mutation hooks.
+func (c Client) deleteRecord(ctx context.Context, zoneID, recordID string, snapshot *Record) (bool, error) {
 	endpoint := c.baseURL.JoinPath("dns_zones", zoneID, "records", recordID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint.String(), http.NoBody)
@@ -147,20 +426,82 @@ func (c Client) DeleteRecord(ctx context.Context, zoneID, recordID string) (bool
 		return false, fmt.Errorf("create request: %w", err)
 	}
 
-	var result DeleteResponse
-	err = c.do(req, &result)
+	result, err := do[DeleteResponse](c, req)
 	if err != nil {
 		return false, err
 	}
 
+	if result.Deleted {
+		if c.tagStore != nil {
+			err = c.tagStore.DeleteTags(ctx, recordID)
+			if err != nil {
+				return true, fmt.Errorf("delete tags of the record %s: %w", recordID, err)
+			}
+		}
+
+		if c.recycleBin != nil {
+			c.recycleBin.push(zoneID, *snapshot)
+		}
+
+		c.notify(ctx, Mutation{Operation: OperationDeleteRecord, ZoneID: zoneID, Record: snapshot})
+	}
+
 	return result.Deleted, nil
 }
 
 // GetRecords To list all existing Records of a DNS zone.
 // https://www.nodion.com/en/docs/dns/api/#get-dns-records
 func (c Client) GetRecords(ctx context.Context, zoneID string, filter *RecordsFilter) ([]Record, error) {
+	req, err := c.newRecordsRequest(ctx, zoneID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := do[RecordsResponse](c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.applyTags(ctx, filterRecordTypes(decodeRecords(result.Records), filter), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter != nil {
+		sortRecords(records, filter.SortBy, filter.Descending)
+	}
+
+	return records, nil
+}
+
+func (c Client) newRecordsRequest(ctx context.Context, zoneID string, filter *RecordsFilter) (*http.Request, error) {
 	endpoint := c.baseURL.JoinPath("dns_zones", zoneID, "records")
 
+	if filter != nil {
+		if filter.RecordType != "" && len(filter.RecordTypes) > 0 {
+			return nil, errors.New("the record filter cannot use both RecordType and RecordTypes")
+		}
+
+		err := validateSort(filter.SortBy, true)
+		if err != nil {
+			return nil, err
+		}
+
+		query := *filter
+		query.RecordType = filter.RecordType.normalized()
+
+		if len(filter.RecordTypes) == 1 {
+			query.RecordType = filter.RecordTypes[0].normalized()
+		}
+
+		if query.RecordType == TypeTXT && query.Content != "" {
+			// the TXT values can be stored quoted.
+			query.Content = encodeTXT(query.Content)
+		}
+
+		filter = &query
+	}
+
 	values, err := querystring.Values(filter)
 	if err != nil {
 		return nil, fmt.Errorf("create records filter: %w", err)
@@ -173,52 +514,307 @@ func (c Client) GetRecords(ctx context.Context, zoneID string, filter *RecordsFi
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	var result RecordsResponse
-	err = c.do(req, &result)
+	return req, nil
+}
+
+// filterRecordTypes keeps the records matching the RecordTypes of the filter.
+func filterRecordTypes(records []Record, filter *RecordsFilter) []Record {
+	if filter == nil || len(filter.RecordTypes) < 2 {
+		return records
+	}
+
+	var result []Record
+
+	for _, record := range records {
+		for _, recordType := range filter.RecordTypes {
+			if record.RecordType == recordType.normalized() {
+				result = append(result, record)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Do sends a request to the API, and decodes the response into result (if not nil).
+// The authentication, the headers, the timeout, the cache, and the error handling of the client are applied.
+// It's an escape hatch to call the endpoints not covered by the client.
+func (c Client) Do(req *http.Request, result any) error {
+	resetCallInfo(req.Context())
+
+	err := c.checkMethod(req.Method)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	err = c.checkZone(req)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkFreeze(req)
+	if err != nil {
+		return err
+	}
+
+	req, cancel, err := c.prepare(req)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+
+	if req.Method != http.MethodGet {
+		zoneID := zoneIDFromPath(c.baseURL, req.URL)
+
+		_, unlock := c.lockZone(req.Context(), zoneID)
+		resp, err := c.send(req)
+		unlock()
+
+		if err != nil {
+			return err
+		}
+
+		c.cache.invalidate(zoneID)
+
+		return c.unmarshal(req, resp.raw, result, resp.statusCode)
 	}
 
-	return result.Records, nil
+	key := req.URL.String()
+
+	if raw, age, ok := c.cache.get(key, c.clock); ok {
+		setCallInfo(req.Context(), false, age)
+
+		return c.unmarshal(req, raw, result, http.StatusOK)
+	}
+
+	resp, err := c.sendShared(req, key)
+	if err != nil {
+		// During an outage, the stale cached response is used (see WithStaleCache).
+		raw, age, ok := c.cache.getStale(key, c.clock)
+		if !ok || !isOutage(req.Context(), err) {
+			return err
+		}
+
+		setCallInfo(req.Context(), true, age)
+
+		return c.unmarshal(req, raw, result, http.StatusOK)
+	}
+
+	return c.unmarshal(req, resp.raw, result, resp.statusCode)
 }
 
-func (c Client) do(req *http.Request, result any) error {
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+// sendShared sends a GET request.
+// Concurrent identical GET requests are deduplicated:
+// only one request is sent to the API, and the response is shared.
+func (c Client) sendShared(req *http.Request, key string) (*rawResponse, error) {
+	ch := c.flight.DoChan(key, func() (any, error) {
+		resp, err := c.send(req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.set(key, zoneIDFromPath(c.baseURL, req.URL), resp.raw, c.clock)
+
+		return resp, nil
+	})
+
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+
+	case res := <-ch:
+		if res.Err == nil {
+			resp, _ := res.Val.(*rawResponse)
+
+			return resp, nil
+		}
+
+		// The shared request has been canceled by the context of another caller:
+		// the request is sent again with the context of this caller.
+		if req.Context().Err() == nil && (errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded)) {
+			return c.send(req)
+		}
+
+		return nil, res.Err
+	}
+}
+
+// do sends a request and returns the decoded response.
+func do[T any](c Client, req *http.Request) (T, error) {
+	var result T
+
+	err := c.Do(req, &result)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
 
+// prepare sets the headers and the timeout of a request.
+func (c Client) prepare(req *http.Request) (*http.Request, context.CancelFunc, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	c.correlation.apply(req)
+
+	err := c.editRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if c.requestCompression {
+		err := compressBody(req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = c.authenticator.Authenticate(req)
 	if err != nil {
-		return fmt.Errorf("API error: %w", err)
+		return nil, nil, fmt.Errorf("authenticate request: %w", err)
+	}
+
+	ctx, cancel := c.withTimeout(req.Context())
+
+	return req.WithContext(ctx), cancel, nil
+}
+
+type rawResponse struct {
+	raw        []byte
+	statusCode int
+}
+
+// send sends a request (with the retries, see WithRetry), and reads the response.
+func (c Client) send(req *http.Request) (*rawResponse, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.sendOnce(req)
+
+		delay, ok := c.retrier.retry(req, attempt, err)
+		if !ok {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, err
+
+		case <-timer.C:
+		}
+	}
+}
+
+func (c Client) sendOnce(req *http.Request) (*rawResponse, error) {
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		return nil, err
 	}
 
 	defer func() { _ = resp.Body.Close() }()
 
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	c.logResponse(req, resp.StatusCode, raw)
+
+	return &rawResponse{raw: raw, statusCode: resp.StatusCode}, nil
+}
+
+// roundTrip sends a request, the caller must close the body of the response.
+func (c Client) roundTrip(req *http.Request) (*http.Response, error) {
+	err := c.breaker.allow(c.clock)
+	if err != nil {
+		return nil, err
+	}
+
+	req, trace := c.traceTimings(req)
+
+	start := time.Now()
+
+	resp, err := c.HTTPClient.Do(req)
+
+	c.reportTimings(req, trace)
+	c.logRequest(req, resp, start, err)
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			// the request has been canceled by the caller: the probe is released without result.
+			c.breaker.release()
+		} else {
+			c.breaker.record(true, c.clock)
+		}
+
+		return nil, fmt.Errorf("API error: %w", err)
+	}
+
+	c.breaker.record(resp.StatusCode >= 500, c.clock)
+
+	c.rateLimit.update(resp.Header, c.clock)
+
+	c.checkDeprecation(req, resp.Header)
+
 	if resp.StatusCode/100 != 2 {
-		return readError(req.URL, resp)
+		defer func() { _ = resp.Body.Close() }()
+
+		return nil, readError(req.URL, resp)
 	}
 
-	raw, err := io.ReadAll(resp.Body)
+	return resp, nil
+}
+
+func (c Client) encode(v any) (io.Reader, error) {
+	raw, err := c.codec.Marshal(v)
 	if err != nil {
-		return fmt.Errorf("read response body: %w", err)
+		return nil, fmt.Errorf("encode request body: %w", err)
 	}
 
-	err = json.Unmarshal(raw, result)
+	return bytes.NewReader(raw), nil
+}
+
+func (c Client) unmarshal(req *http.Request, raw []byte, result any, statusCode int) error {
+	if result == nil {
+		return nil
+	}
+
+	err := c.codec.Unmarshal(raw, result)
 	if err != nil {
-		return fmt.Errorf("unmarshaling %T error [status code=%d]: %w: %s", result, resp.StatusCode, err, string(raw))
+		return fmt.Errorf("unmarshaling %T error [status code=%d]: %w: %s", result, statusCode, err, string(raw))
 	}
 
+	c.drift.detect(req, raw, result)
+
 	return nil
 }
 
+// zoneIDFromPath extracts the zone ID from an endpoint like `dns_zones/<zoneID>/...`.
+// Returns an empty string for endpoints that are not related to a specific zone.
+func zoneIDFromPath(baseURL, endpoint *url.URL) string {
+	parts := strings.Split(relativePath(baseURL, endpoint), "/")
+	if len(parts) < 2 || parts[0] != "dns_zones" {
+		return ""
+	}
+
+	return parts[1]
+}
+
 func readError(endpoint *url.URL, resp *http.Response) error {
 	content, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return errors.New(toUnreadableBodyMessage(endpoint, content))
 	}
 
-	errAPI := &APIError{StatusCode: resp.StatusCode}
+	errAPI := &APIError{StatusCode: resp.StatusCode, response: resp}
 
 	if len(content) == 0 {
 		errAPI.Errors = []string{http.StatusText(resp.StatusCode)}
@@ -2,68 +2,61 @@ package nodion
 
 import (
 	"context"
-	"fmt"
-	"io"
 	"net/http"
-	"net/http/httptest"
 	"net/url"
-	"os"
-	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/nrdcg/nodion/nodiontest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func setupTest(t *testing.T, pattern string, handler http.HandlerFunc) *Client {
+func setupTest(t *testing.T, pattern string, handler http.HandlerFunc, opts ...Option) *Client {
 	t.Helper()
 
-	mux := http.NewServeMux()
-	server := httptest.NewServer(mux)
-	t.Cleanup(server.Close)
+	client, server := setupTestMux(t, opts...)
 
-	client, err := NewClient("secret")
+	server.HandleFunc(pattern, handler)
+
+	return client
+}
+
+func setupTestMux(t *testing.T, opts ...Option) (*Client, *nodiontest.Server) {
+	t.Helper()
+
+	server := nodiontest.NewServer(t)
+
+	client, err := NewClient(nodiontest.Token, opts...)
 	require.NoError(t, err)
 
 	client.HTTPClient = server.Client()
 	client.baseURL, _ = url.Parse(server.URL)
 
-	mux.HandleFunc(pattern, handler)
-
-	return client
+	return client, server
 }
 
 func readFileHandler(method string, statusCode int, filename string) http.HandlerFunc {
-	return func(rw http.ResponseWriter, req *http.Request) {
-		if req.Method != method {
-			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
-			return
-		}
-
-		authorization := req.Header.Get("Authorization")
-		if authorization != "Bearer secret" {
-			http.Error(rw, fmt.Sprintf("invalid API key: %s", authorization), http.StatusUnauthorized)
-			return
-		}
-
-		//nolint:gosec // Only for testing purpose.
-		file, err := os.Open(filepath.Join("fixtures", filename))
-		if err != nil {
-			http.Error(rw, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		defer func() { _ = file.Close() }()
-
-		rw.WriteHeader(statusCode)
-		_, err = io.Copy(rw, file)
-		if err != nil {
-			http.Error(rw, err.Error(), http.StatusInternalServerError)
-			return
-		}
+	return nodiontest.FixtureHandler(method, statusCode, filename)
+}
+
+// zoneInUTC converts the timestamps of a zone and its records to UTC:
+// a timestamp is parsed in the local time zone when its offset matches the local offset.
+func zoneInUTC(zone *Zone) {
+	zone.CreatedAt = zone.CreatedAt.UTC()
+	zone.UpdatedAt = zone.UpdatedAt.UTC()
+
+	for i := range zone.Records {
+		recordInUTC(&zone.Records[i])
 	}
 }
 
+// recordInUTC converts the timestamps of a record to UTC (see zoneInUTC).
+func recordInUTC(record *Record) {
+	record.CreatedAt = record.CreatedAt.UTC()
+	record.UpdatedAt = record.UpdatedAt.UTC()
+}
+
 func TestClient_CreateZone(t *testing.T) {
 	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json"))
 
@@ -72,14 +65,11 @@ func TestClient_CreateZone(t *testing.T) {
 
 	require.NotNil(t, zone)
 
-	// hack to compare date
-	location := zone.CreatedAt.Location()
-
 	expected := &Zone{
 		ID:        "52be5f1b-fee7-4a42-b668-85890c41be5b",
 		Name:      "nodionsample.com",
-		CreatedAt: time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-		UpdatedAt: time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+		CreatedAt: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		Records: []Record{
 			{
 				ID:         "5ed9465f-f8c6-432d-9474-3e9880f6adfe",
@@ -88,8 +78,8 @@ func TestClient_CreateZone(t *testing.T) {
 				Content:    "1.2.3.4",
 				TTL:        3600,
 				ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-				CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-				UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+				CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			},
 			{
 				ID:         "60a0647b-0b08-4dc0-8d51-4e21c799457c",
@@ -98,8 +88,8 @@ func TestClient_CreateZone(t *testing.T) {
 				Content:    "1.2.3.4",
 				TTL:        3600,
 				ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-				CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-				UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+				CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			},
 			{
 				ID:         "b4748041-f3b2-40f3-9217-9af016c5937f",
@@ -108,8 +98,8 @@ func TestClient_CreateZone(t *testing.T) {
 				Content:    "1.2.3.4",
 				TTL:        3600,
 				ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-				CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-				UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+				CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			},
 			{
 				ID:         "d13e85ce-7d04-4770-9197-19f87f35e6a8",
@@ -118,8 +108,8 @@ func TestClient_CreateZone(t *testing.T) {
 				Content:    "ns1.nodion.com",
 				TTL:        3600,
 				ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-				CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-				UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+				CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			},
 			{
 				ID:         "f5454bf7-f89b-45a4-981f-7783102fd389",
@@ -128,12 +118,13 @@ func TestClient_CreateZone(t *testing.T) {
 				Content:    "ns2.nodion.com",
 				TTL:        3600,
 				ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-				CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-				UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+				CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			},
 		},
 	}
 
+	zoneInUTC(zone)
 	assert.Equal(t, expected, zone)
 }
 
@@ -170,15 +161,12 @@ func TestClient_GetZones(t *testing.T) {
 
 	require.Len(t, zones, 1)
 
-	// hack to compare date
-	location := zones[0].CreatedAt.Location()
-
 	expected := []Zone{
 		{
 			ID:        "52be5f1b-fee7-4a42-b668-85890c41be5b",
 			Name:      "nodionsample.com",
-			CreatedAt: time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt: time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 			Records: []Record{
 				{
 					ID:         "5ed9465f-f8c6-432d-9474-3e9880f6adfe",
@@ -187,8 +175,8 @@ func TestClient_GetZones(t *testing.T) {
 					Content:    "1.2.3.4",
 					TTL:        3600,
 					ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-					CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-					UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+					CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 				},
 				{
 					ID:         "60a0647b-0b08-4dc0-8d51-4e21c799457c",
@@ -197,8 +185,8 @@ func TestClient_GetZones(t *testing.T) {
 					Content:    "1.2.3.4",
 					TTL:        3600,
 					ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-					CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-					UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+					CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 				},
 				{
 					ID:         "b4748041-f3b2-40f3-9217-9af016c5937f",
@@ -207,8 +195,8 @@ func TestClient_GetZones(t *testing.T) {
 					Content:    "1.2.3.4",
 					TTL:        3600,
 					ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-					CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-					UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+					CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 				},
 				{
 					ID:         "d13e85ce-7d04-4770-9197-19f87f35e6a8",
@@ -217,8 +205,8 @@ func TestClient_GetZones(t *testing.T) {
 					Content:    "ns1.nodion.com",
 					TTL:        3600,
 					ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-					CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-					UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+					CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 				},
 				{
 					ID:         "f5454bf7-f89b-45a4-981f-7783102fd389",
@@ -227,13 +215,17 @@ func TestClient_GetZones(t *testing.T) {
 					Content:    "ns2.nodion.com",
 					TTL:        3600,
 					ZoneID:     "52be5f1b-fee7-4a42-b668-85890c41be5b",
-					CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-					UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+					CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 				},
 			},
 		},
 	}
 
+	for i := range zones {
+		zoneInUTC(&zones[i])
+	}
+
 	assert.Equal(t, expected, zones)
 }
 
@@ -252,9 +244,6 @@ func TestClient_GetRecords(t *testing.T) {
 
 	require.Len(t, records, 5)
 
-	// hack to compare date
-	location := records[0].CreatedAt.Location()
-
 	expected := []Record{
 		{
 			ID:         "8231bac6-39f0-4f06-bd6c-076fb9abea9e",
@@ -263,8 +252,8 @@ func TestClient_GetRecords(t *testing.T) {
 			Content:    "1.2.3.4",
 			TTL:        3600,
 			ZoneID:     "",
-			CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		},
 		{
 			ID:         "25adc6de-ee1e-4e94-916a-be3f4bcaa586",
@@ -273,8 +262,8 @@ func TestClient_GetRecords(t *testing.T) {
 			Content:    "1.2.3.4",
 			TTL:        3600,
 			ZoneID:     "",
-			CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		},
 		{
 			ID:         "843fa60c-dc30-47c4-a818-fee31118a43f",
@@ -283,8 +272,8 @@ func TestClient_GetRecords(t *testing.T) {
 			Content:    "1.2.3.4",
 			TTL:        3600,
 			ZoneID:     "",
-			CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		},
 		{
 			ID:         "a10acb05-c76f-4170-9e27-74bb9a6c6cdc",
@@ -293,8 +282,8 @@ func TestClient_GetRecords(t *testing.T) {
 			Content:    "ns1.nodion.com",
 			TTL:        3600,
 			ZoneID:     "",
-			CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		},
 		{
 			ID:         "924f32d4-b10f-47ef-a293-adbc7169e885",
@@ -303,11 +292,15 @@ func TestClient_GetRecords(t *testing.T) {
 			Content:    "ns2.nodion.com",
 			TTL:        3600,
 			ZoneID:     "",
-			CreatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
-			UpdatedAt:  time.Date(2023, time.January, 1, 10, 0, 0, 0, location),
+			CreatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
 		},
 	}
 
+	for i := range records {
+		recordInUTC(&records[i])
+	}
+
 	assert.Equal(t, expected, records)
 }
 
@@ -318,6 +311,28 @@ func TestClient_GetRecords_error(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestClient_GetRecords_recordTypes(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones/xxx/records", http.StatusOK, "get-dns-zones-records.json")
+
+	records, err := client.GetRecords(context.Background(), "xxx", &RecordsFilter{RecordTypes: []RecordType{TypeAAAA, TypeNS}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []RecordType{TypeNS, TypeNS}, recordTypes(records))
+
+	_, err = client.GetRecords(context.Background(), "xxx", &RecordsFilter{RecordTypes: []RecordType{TypeNS}})
+	require.NoError(t, err)
+
+	_, err = client.GetRecords(context.Background(), "xxx", &RecordsFilter{RecordType: TypeA, RecordTypes: []RecordType{TypeNS}})
+	require.EqualError(t, err, "the record filter cannot use both RecordType and RecordTypes")
+
+	requests := mux.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "", requests[0].Query.Get("record_type"))
+	assert.Equal(t, "ns", requests[1].Query.Get("record_type"))
+}
+
 func TestClient_CreateRecord(t *testing.T) {
 	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json"))
 
@@ -333,9 +348,6 @@ func TestClient_CreateRecord(t *testing.T) {
 
 	require.NotNil(t, newRecord)
 
-	// hack to compare date
-	location := newRecord.CreatedAt.Location()
-
 	expected := &Record{
 		ID:         "748d688a-3004-4b84-b8b8-8cb2e07c5c71",
 		RecordType: "a",
@@ -343,10 +355,11 @@ func TestClient_CreateRecord(t *testing.T) {
 		Content:    "1.2.3.4",
 		TTL:        60,
 		ZoneID:     "",
-		CreatedAt:  time.Date(2023, time.February, 10, 21, 32, 54, 749000000, location),
-		UpdatedAt:  time.Date(2023, time.February, 10, 21, 32, 54, 749000000, location),
+		CreatedAt:  time.Date(2023, time.February, 10, 20, 32, 54, 749000000, time.UTC),
+		UpdatedAt:  time.Date(2023, time.February, 10, 20, 32, 54, 749000000, time.UTC),
 	}
 
+	recordInUTC(newRecord)
 	assert.Equal(t, expected, newRecord)
 }
 
@@ -381,3 +394,58 @@ func TestClient_DeleteRecord_error(t *testing.T) {
 
 	assert.False(t, result)
 }
+
+func TestClient_DeleteRecord_conflict(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records/yyy", readFileHandler(http.MethodDelete, http.StatusConflict, "create-dns-zone-record-error.json"))
+
+	_, err := client.DeleteRecord(context.Background(), "xxx", "yyy")
+	require.ErrorIs(t, err, ErrConflict)
+}
+
+func TestClient_Do(t *testing.T) {
+	client := setupTest(t, "/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "example.com", req.URL.Query().Get("name"))
+		assert.Equal(t, "nrdcg-nodion", req.UserAgent())
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "dns_zones?name=example.com", nil)
+	require.NoError(t, err)
+
+	var result map[string]any
+
+	err = client.Do(req, &result)
+	require.NoError(t, err)
+
+	assert.Contains(t, result, "dns_zones")
+}
+
+func TestClient_Do_error(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-error.json"))
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "dns_zones", nil)
+	require.NoError(t, err)
+
+	err = client.Do(req, nil)
+
+	var errAPI *APIError
+	require.ErrorAs(t, err, &errAPI)
+
+	assert.Equal(t, http.StatusNotFound, errAPI.StatusCode)
+}
+
+func TestClient_NewRequest_body(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json"), WithUserAgent("test"))
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "dns_zones/xxx/records", Record{Name: "www"})
+	require.NoError(t, err)
+
+	var result RecordResponse
+
+	err = client.Do(req, &result)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", req.Header.Get("User-Agent"))
+	assert.Equal(t, "748d688a-3004-4b84-b8b8-8cb2e07c5c71", result.Record.ID)
+}
@@ -0,0 +1,81 @@
+package nodion
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// Clock provides the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// TimerClock is a Clock which also provides the timers of the client,
+// so the waits for a point in time (see Scheduler) follow the clock, e.g. a fake clock advanced by a test.
+// The timers of a Clock which doesn't implement TimerClock are real timers.
+type TimerClock interface {
+	Clock
+	// After waits for the duration to elapse, and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// IDGenerator generates the client-side unique identifiers (ex: Mutation.ID).
+type IDGenerator interface {
+	NewID() string
+}
+
+// WithClock sets the clock used by the client (cache expiration, mutation time, ...).
+// It's mainly useful for deterministic tests.
+func WithClock(clock Clock) Option {
+	return func(c *Client) error {
+		if clock == nil {
+			return errors.New("clock is required")
+		}
+
+		c.clock = clock
+
+		return nil
+	}
+}
+
+// WithIDGenerator sets the generator of the client-side unique identifiers.
+// It's mainly useful for deterministic tests.
+func WithIDGenerator(generator IDGenerator) Option {
+	return func(c *Client) error {
+		if generator == nil {
+			return errors.New("ID generator is required")
+		}
+
+		c.idGenerator = generator
+
+		return nil
+	}
+}
+
+// after returns a channel receiving the time after a duration (see TimerClock), and a function releasing the timer.
+func (c Client) after(d time.Duration) (<-chan time.Time, func()) {
+	if clock, ok := c.clock.(TimerClock); ok {
+		return clock.After(d), func() {}
+	}
+
+	timer := time.NewTimer(d)
+
+	return timer.C, func() { timer.Stop() }
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type randomIDGenerator struct{}
+
+func (randomIDGenerator) NewID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
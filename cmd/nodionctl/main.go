@@ -0,0 +1,152 @@
+// nodionctl is a command line client for the Nodion DNS API.
+//
+// The API token is read from the NODION_API_TOKEN environment variable,
+// the API URL can be overridden with the NODION_API_URL environment variable.
+//
+//	nodionctl zones list
+//	nodionctl zones create example.com
+//	nodionctl records list -zone <zone ID> -type a
+//	nodionctl records create -zone <zone ID> -type a -name www -content 192.0.2.1
+//	nodionctl zone sync example.com.yaml --dry-run
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/nrdcg/nodion"
+)
+
+const usage = `Usage: nodionctl <command> <subcommand> [flags] [args]
+
+Commands:
+  zones    list | create | delete | export | import | sync
+  records  list | create | update | delete | watch
+
+Environment:
+  NODION_API_TOKEN  the API token (required)
+  NODION_API_URL    the API URL (optional)
+
+Run 'nodionctl <command> <subcommand> -h' for the flags of a subcommand.
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	app := cli{
+		getenv: os.Getenv,
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+
+	err := app.run(ctx, os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+
+		_, _ = fmt.Fprintln(os.Stderr, "nodionctl:", err)
+		os.Exit(1)
+	}
+}
+
+type cli struct {
+	getenv func(string) string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (a cli) run(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		_, _ = fmt.Fprint(a.stderr, usage)
+		return flag.ErrHelp
+	}
+
+	var commands map[string]func(context.Context, []string) error
+
+	switch args[0] {
+	case "zones", "zone":
+		commands = map[string]func(context.Context, []string) error{
+			"list":   a.listZones,
+			"create": a.createZone,
+			"delete": a.deleteZone,
+			"export": a.exportZone,
+			"import": a.importZone,
+			"sync":   a.syncZone,
+		}
+
+	case "records", "record":
+		commands = map[string]func(context.Context, []string) error{
+			"list":   a.listRecords,
+			"create": a.createRecord,
+			"update": a.updateRecord,
+			"delete": a.deleteRecord,
+			"watch":  a.watchRecords,
+		}
+
+	default:
+		_, _ = fmt.Fprint(a.stderr, usage)
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+
+	cmd, ok := commands[args[1]]
+	if !ok {
+		_, _ = fmt.Fprint(a.stderr, usage)
+		return fmt.Errorf("unknown subcommand: %s %s", args[0], args[1])
+	}
+
+	return cmd(ctx, args[2:])
+}
+
+func (a cli) newClient() (*nodion.Client, error) {
+	token := a.getenv("NODION_API_TOKEN")
+	if token == "" {
+		return nil, errors.New("missing API token: set NODION_API_TOKEN")
+	}
+
+	var opts []nodion.Option
+
+	if apiURL := a.getenv("NODION_API_URL"); apiURL != "" {
+		opts = append(opts, nodion.WithBaseURL(apiURL))
+	}
+
+	opts = append(opts, nodion.WithUserAgent("nodionctl"))
+
+	return nodion.NewClient(token, opts...)
+}
+
+func (a cli) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("nodionctl "+name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+
+	return fs
+}
+
+// parseFlags parses the flags, which can be interspersed with the positional arguments,
+// and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nrdcg/nodion"
+	"github.com/nrdcg/nodion/nodiontest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const zoneID = "52be5f1b-fee7-4a42-b668-85890c41be5b"
+
+func setupCLI(t *testing.T) (*cli, *nodiontest.Server, *bytes.Buffer) {
+	t.Helper()
+
+	server := nodiontest.NewServer(t)
+
+	env := map[string]string{
+		"NODION_API_TOKEN": nodiontest.Token,
+		"NODION_API_URL":   server.URL,
+	}
+
+	stdout := &bytes.Buffer{}
+
+	app := &cli{
+		getenv: func(key string) string { return env[key] },
+		stdin:  strings.NewReader(""),
+		stdout: stdout,
+		stderr: &bytes.Buffer{},
+	}
+
+	return app, server, stdout
+}
+
+func TestCLI_zonesList(t *testing.T) {
+	app, server, stdout := setupCLI(t)
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	err := app.run(context.Background(), []string{"zones", "list"})
+	require.NoError(t, err)
+
+	expected := `ID                                    NAME              CREATED
+52be5f1b-fee7-4a42-b668-85890c41be5b  nodionsample.com  2023-01-01
+`
+	assert.Equal(t, expected, stdout.String())
+}
+
+func TestCLI_zonesList_json(t *testing.T) {
+	app, server, stdout := setupCLI(t)
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	err := app.run(context.Background(), []string{"zones", "list", "-o", "json", "-name", "nodionsample.com"})
+	require.NoError(t, err)
+
+	var zones []nodion.Zone
+
+	err = json.Unmarshal(stdout.Bytes(), &zones)
+	require.NoError(t, err)
+
+	require.Len(t, zones, 1)
+	assert.Equal(t, zoneID, zones[0].ID)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "nodionsample.com", requests[0].Query.Get("name"))
+}
+
+func TestCLI_zonesDelete_unconfirmed(t *testing.T) {
+	app, server, _ := setupCLI(t)
+
+	err := app.run(context.Background(), []string{"zones", "delete", zoneID})
+	require.EqualError(t, err, "zones delete: the deletion must be confirmed with -yes")
+
+	assert.Empty(t, server.Requests())
+}
+
+func TestCLI_recordsList(t *testing.T) {
+	app, server, stdout := setupCLI(t)
+
+	server.HandleFixture(http.MethodGet, "/dns_zones/"+zoneID+"/records", http.StatusOK, "get-dns-zones-records.json")
+
+	err := app.run(context.Background(), []string{"records", "list", "-zone", zoneID, "-type", "a"})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.NotEmpty(t, lines)
+	assert.Equal(t, []string{"ID", "TYPE", "NAME", "CONTENT", "TTL"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"8231bac6-39f0-4f06-bd6c-076fb9abea9e", "a", "@", "1.2.3.4", "3600"}, strings.Fields(lines[1]))
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "a", requests[0].Query.Get("record_type"))
+}
+
+func TestCLI_recordsCreate(t *testing.T) {
+	app, server, _ := setupCLI(t)
+
+	server.HandleFixture(http.MethodPost, "/dns_zones/"+zoneID+"/records", http.StatusOK, "create-dns-zone-record.json")
+
+	err := app.run(context.Background(), []string{"records", "create", "-zone", zoneID, "-type", "a", "-name", "www", "-content", "1.2.3.4", "-ttl", "300"})
+	require.NoError(t, err)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+
+	var record nodion.Record
+
+	err = json.Unmarshal(requests[0].Body, &record)
+	require.NoError(t, err)
+
+	assert.Equal(t, nodion.Record{RecordType: "a", Name: "www", Content: "1.2.3.4", TTL: 300}, record)
+}
+
+func TestCLI_missingToken(t *testing.T) {
+	app, _, _ := setupCLI(t)
+	app.getenv = func(string) string { return "" }
+
+	err := app.run(context.Background(), []string{"zones", "list"})
+	require.EqualError(t, err, "missing API token: set NODION_API_TOKEN")
+}
+
+func TestCLI_unknownCommand(t *testing.T) {
+	app, _, _ := setupCLI(t)
+
+	err := app.run(context.Background(), []string{"domains", "list"})
+	require.EqualError(t, err, "unknown command: domains")
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/nrdcg/nodion"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+func addOutputFlag(fs *flag.FlagSet) *string {
+	return fs.String("o", formatTable, "output format: table or json")
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func writeZones(w io.Writer, format string, zones []nodion.Zone) error {
+	switch format {
+	case formatJSON:
+		return writeJSON(w, zones)
+
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		_, _ = fmt.Fprintln(tw, "ID\tNAME\tCREATED")
+
+		for _, zone := range zones {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", zone.ID, zone.Name, orDash(zone.CreatedAt.IsZero(), zone.CreatedAt.Format("2006-01-02")))
+		}
+
+		return tw.Flush()
+
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+}
+
+func writeRecords(w io.Writer, format string, records []nodion.Record) error {
+	switch format {
+	case formatJSON:
+		return writeJSON(w, records)
+
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		_, _ = fmt.Fprintln(tw, "ID\tTYPE\tNAME\tCONTENT\tTTL")
+
+		for _, record := range records {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+				record.ID, record.RecordType, record.Name, record.Content, orDash(record.TTL == 0, strconv.Itoa(record.TTL)))
+		}
+
+		return tw.Flush()
+
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+}
+
+func orDash(empty bool, value string) string {
+	if empty {
+		return "-"
+	}
+
+	return value
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/nrdcg/nodion"
+)
+
+func (a cli) listRecords(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("records list")
+	zoneID := fs.String("zone", "", "the zone ID (required)")
+	name := fs.String("name", "", "filter on the record name")
+	recordType := addRecordTypeFlag(fs, "filter on the record type")
+	content := fs.String("content", "", "filter on the record content")
+	format := addOutputFlag(fs)
+
+	_, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *zoneID == "" {
+		return errors.New("records list: -zone is required")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	records, err := client.GetRecords(ctx, *zoneID, &nodion.RecordsFilter{Name: *name, RecordType: *recordType, Content: *content})
+	if err != nil {
+		return err
+	}
+
+	return writeRecords(a.stdout, *format, records)
+}
+
+func (a cli) createRecord(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("records create")
+	zoneID := fs.String("zone", "", "the zone ID (required)")
+	record := addRecordFlags(fs)
+	format := addOutputFlag(fs)
+
+	_, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *zoneID == "" || record.RecordType == "" || record.Content == "" {
+		return errors.New("records create: -zone, -type and -content are required")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	created, err := client.CreateRecord(ctx, *zoneID, *record)
+	if err != nil {
+		return err
+	}
+
+	return writeRecords(a.stdout, *format, []nodion.Record{*created})
+}
+
+// updateRecord replaces a record (the API has no update endpoint: the new record is created, then the old one is deleted).
+func (a cli) updateRecord(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("records update")
+	zoneID := fs.String("zone", "", "the zone ID (required)")
+	recordID := fs.String("id", "", "the record ID (required)")
+	changes := addRecordFlags(fs)
+	format := addOutputFlag(fs)
+
+	_, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *zoneID == "" || *recordID == "" {
+		return errors.New("records update: -zone and -id are required")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	current, err := findRecord(ctx, client, *zoneID, *recordID)
+	if err != nil {
+		return err
+	}
+
+	desired := nodion.Record{
+		RecordType: current.RecordType,
+		Name:       current.Name,
+		Content:    current.Content,
+		TTL:        current.TTL,
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "type":
+			desired.RecordType = changes.RecordType
+		case "name":
+			desired.Name = changes.Name
+		case "content":
+			desired.Content = changes.Content
+		case "ttl":
+			desired.TTL = changes.TTL
+		}
+	})
+
+	created, err := client.BeginChangeSet(*zoneID).Update(*current, desired).Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeRecords(a.stdout, *format, created)
+}
+
+func (a cli) deleteRecord(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("records delete")
+	zoneID := fs.String("zone", "", "the zone ID (required)")
+	recordID := fs.String("id", "", "the record ID (required)")
+
+	_, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *zoneID == "" || *recordID == "" {
+		return errors.New("records delete: -zone and -id are required")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := client.DeleteRecord(ctx, *zoneID, *recordID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("record %s not deleted", *recordID)
+	}
+
+	return nil
+}
+
+func addRecordFlags(fs *flag.FlagSet) *nodion.Record {
+	record := &nodion.Record{}
+
+	fs.Func("type", "the record type (a, aaaa, cname, mx, txt, ...)", recordTypeFlag(&record.RecordType))
+	fs.StringVar(&record.Name, "name", "", "the record name, relative to the zone (empty for the apex)")
+	fs.StringVar(&record.Content, "content", "", "the record content")
+	fs.IntVar(&record.TTL, "ttl", 3600, "the record TTL in seconds")
+
+	return record
+}
+
+func addRecordTypeFlag(fs *flag.FlagSet, usage string) *nodion.RecordType {
+	recordType := new(nodion.RecordType)
+
+	fs.Func("type", usage, recordTypeFlag(recordType))
+
+	return recordType
+}
+
+func recordTypeFlag(recordType *nodion.RecordType) func(string) error {
+	return func(value string) error {
+		t, err := nodion.ParseRecordType(value)
+		if err != nil {
+			return err
+		}
+
+		*recordType = t
+
+		return nil
+	}
+}
+
+func findRecord(ctx context.Context, client *nodion.Client, zoneID, recordID string) (*nodion.Record, error) {
+	records, err := client.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.ID == recordID {
+			return &record, nil
+		}
+	}
+
+	return nil, fmt.Errorf("record %s not found in zone %s", recordID, zoneID)
+}
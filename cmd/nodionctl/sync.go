@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nrdcg/nodion"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	colorGreen = "\x1b[32m"
+	colorRed   = "\x1b[31m"
+	colorReset = "\x1b[0m"
+)
+
+// zoneFile is the declarative specification of a zone.
+//
+//	name: example.com
+//	ttl: 3600
+//	records:
+//	  - type: a
+//	    name: www
+//	    content: 192.0.2.1
+//	  - type: mx
+//	    name: "@"
+//	    content: 10 mail.example.com
+//	    ttl: 300
+type zoneFile struct {
+	Name    string       `yaml:"name"`
+	TTL     int          `yaml:"ttl"`
+	Records []recordSpec `yaml:"records"`
+}
+
+type recordSpec struct {
+	Type    string `yaml:"type"`
+	Name    string `yaml:"name"`
+	Content string `yaml:"content"`
+	TTL     int    `yaml:"ttl"`
+}
+
+func (a cli) syncZone(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones sync <file>")
+	zoneID := fs.String("zone", "", "the ID of the zone (default: the zone named in the file, created if needed)")
+	dryRun := fs.Bool("dry-run", false, "print the plan without applying it")
+	noColor := fs.Bool("no-color", false, "disable the colors of the plan (also disabled by NO_COLOR)")
+	manageApexNS := fs.Bool("manage-apex-ns", false, "include the NS records of the apex in the reconciliation")
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("zones sync: expected a zone file (- for stdin)")
+	}
+
+	spec, err := a.readZoneFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	desired, err := spec.records()
+	if err != nil {
+		return err
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	zone, err := lookupZone(ctx, client, *zoneID, spec.Name)
+	if err != nil {
+		return err
+	}
+
+	syncOpts := nodion.SyncOptions{ManageApexNS: *manageApexNS}
+
+	var plan *nodion.Plan
+
+	if zone == nil {
+		// the zone will be created: everything must be created.
+		plan = &nodion.Plan{ZoneName: spec.Name, Create: desired}
+	} else {
+		plan, err = client.PlanZoneSync(ctx, zone.ID, desired, syncOpts)
+		if err != nil {
+			return err
+		}
+	}
+
+	color := !*noColor && a.getenv("NO_COLOR") == ""
+
+	writePlan(a.stdout, plan, color)
+
+	if *dryRun || plan.Empty() {
+		return nil
+	}
+
+	if zone == nil {
+		zone, err = client.CreateZone(ctx, spec.Name)
+		if err != nil {
+			return err
+		}
+
+		// the zone is created with default records: the plan is made again against the actual records.
+		plan, err = client.PlanZoneSync(ctx, zone.ID, desired, syncOpts)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(a.stdout, "Created zone %s (%s), plan against its default records:\n", zone.Name, zone.ID)
+
+		writePlan(a.stdout, plan, color)
+
+		if plan.Empty() {
+			return nil
+		}
+	}
+
+	_, err = client.ApplyPlan(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(a.stdout, "Applied to zone %s (%s).\n", zone.Name, zone.ID)
+
+	return nil
+}
+
+func (a cli) readZoneFile(filename string) (*zoneFile, error) {
+	var r io.Reader = a.stdin
+
+	if filename != "-" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		defer func() { _ = file.Close() }()
+
+		r = file
+	}
+
+	spec := &zoneFile{}
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	err := dec.Decode(spec)
+	if err != nil {
+		return nil, fmt.Errorf("decode zone file: %w", err)
+	}
+
+	return spec, nil
+}
+
+func (f *zoneFile) records() ([]nodion.Record, error) {
+	ttl := f.TTL
+	if ttl == 0 {
+		ttl = 3600
+	}
+
+	var records []nodion.Record
+
+	for i, spec := range f.Records {
+		if spec.Type == "" || spec.Content == "" {
+			return nil, fmt.Errorf("record %d: type and content are required", i)
+		}
+
+		recordType, err := nodion.ParseRecordType(spec.Type)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+
+		record := nodion.Record{
+			RecordType: recordType,
+			Name:       spec.Name,
+			Content:    spec.Content,
+			TTL:        spec.TTL,
+		}
+
+		if record.TTL == 0 {
+			record.TTL = ttl
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func writePlan(w io.Writer, plan *nodion.Plan, color bool) {
+	if plan.Empty() {
+		_, _ = fmt.Fprintf(w, "Zone %s is up to date.\n", plan.ZoneName)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "Zone %s:\n", plan.ZoneName)
+
+	for _, line := range strings.Split(strings.TrimSuffix(plan.String(), "\n"), "\n") {
+		switch {
+		case !color:
+			_, _ = fmt.Fprintf(w, "  %s\n", line)
+		case strings.HasPrefix(line, "+"):
+			_, _ = fmt.Fprintf(w, "  %s%s%s\n", colorGreen, line, colorReset)
+		default:
+			_, _ = fmt.Fprintf(w, "  %s%s%s\n", colorRed, line, colorReset)
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "Plan: %d to create, %d to delete.\n", len(plan.Create), len(plan.Delete))
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nrdcg/nodion/nodiontest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const zoneFileContent = `name: nodionsample.com
+records:
+  - type: a
+    name: "@"
+    content: 1.2.3.4
+  - type: a
+    name: www
+    content: 1.2.3.4
+  - type: mx
+    name: "@"
+    content: 10 mail.nodionsample.com
+    ttl: 300
+`
+
+func setupSync(t *testing.T) (*cli, *bytes.Buffer, func() []string, string) {
+	t.Helper()
+
+	app, server, stdout := setupCLI(t)
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	server.HandleFunc("/dns_zones/"+zoneID+"/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			handler := nodiontest.FixtureHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")
+			handler(rw, req)
+
+			return
+		}
+
+		handler := nodiontest.FixtureHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")
+		handler(rw, req)
+	})
+	server.Handle("/dns_zones/"+zoneID+"/records/", nodiontest.FixtureHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	filename := filepath.Join(t.TempDir(), "zone.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(zoneFileContent), 0o600))
+
+	calls := func() []string {
+		var result []string
+
+		for _, req := range server.Requests() {
+			result = append(result, req.Method+" "+req.Path)
+		}
+
+		return result
+	}
+
+	return app, stdout, calls, filename
+}
+
+func TestCLI_zoneSync_dryRun(t *testing.T) {
+	app, stdout, calls, filename := setupSync(t)
+
+	err := app.run(context.Background(), []string{"zone", "sync", filename, "--dry-run", "-no-color"})
+	require.NoError(t, err)
+
+	expected := `Zone nodionsample.com:
+  + mx @ "10 mail.nodionsample.com" (ttl 300)
+  - a * "1.2.3.4" (ttl 3600)
+Plan: 1 to create, 1 to delete.
+`
+	assert.Equal(t, expected, stdout.String())
+
+	for _, call := range calls() {
+		assert.Regexp(t, "^GET ", call)
+	}
+}
+
+func TestCLI_zoneSync(t *testing.T) {
+	app, stdout, calls, filename := setupSync(t)
+
+	err := app.run(context.Background(), []string{"zone", "sync", filename})
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "  \x1b[32m+ mx @")
+
+	expected := []string{
+		"GET /dns_zones",
+		"GET /dns_zones",
+		"GET /dns_zones/" + zoneID + "/records",
+		"POST /dns_zones/" + zoneID + "/records",
+		"DELETE /dns_zones/" + zoneID + "/records/25adc6de-ee1e-4e94-916a-be3f4bcaa586",
+	}
+	assert.Equal(t, expected, calls())
+}
+
+func TestCLI_zoneSync_createZone(t *testing.T) {
+	app, server, stdout := setupCLI(t)
+
+	created := false
+
+	server.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			created = true
+
+			nodiontest.FixtureHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json")(rw, req)
+
+			return
+		}
+
+		if !created {
+			_, _ = rw.Write([]byte(`{"dns_zones": []}`))
+			return
+		}
+
+		nodiontest.FixtureHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+	server.HandleFunc("/dns_zones/"+zoneID+"/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			nodiontest.FixtureHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")(rw, req)
+			return
+		}
+
+		nodiontest.FixtureHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")(rw, req)
+	})
+	server.Handle("/dns_zones/"+zoneID+"/records/", nodiontest.FixtureHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	filename := filepath.Join(t.TempDir(), "zone.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(zoneFileContent), 0o600))
+
+	err := app.run(context.Background(), []string{"zone", "sync", filename, "-no-color"})
+	require.NoError(t, err)
+
+	expected := `Zone nodionsample.com:
+  + a @ "1.2.3.4" (ttl 3600)
+  + a www "1.2.3.4" (ttl 3600)
+  + mx @ "10 mail.nodionsample.com" (ttl 300)
+Plan: 3 to create, 0 to delete.
+Created zone nodionsample.com (` + zoneID + `), plan against its default records:
+Zone nodionsample.com:
+  + mx @ "10 mail.nodionsample.com" (ttl 300)
+  - a * "1.2.3.4" (ttl 3600)
+Plan: 1 to create, 1 to delete.
+Applied to zone nodionsample.com (` + zoneID + `).
+`
+	assert.Equal(t, expected, stdout.String())
+
+	var posts int
+
+	for _, req := range server.Requests() {
+		if req.Method == http.MethodPost && req.Path == "/dns_zones/"+zoneID+"/records" {
+			posts++
+		}
+	}
+
+	assert.Equal(t, 1, posts)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/nrdcg/nodion"
+)
+
+type recordChange struct {
+	Time   time.Time         `json:"time"`
+	Type   nodion.ChangeType `json:"type"`
+	Record nodion.Record     `json:"record"`
+}
+
+// watchRecords polls the records of a zone and prints the changes between two polls.
+func (a cli) watchRecords(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("records watch <zone ID>")
+	interval := fs.Duration("interval", 10*time.Second, "the polling interval")
+	format := fs.String("format", "text", "output format: text or json (one change per line)")
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("records watch: expected a zone ID")
+	}
+
+	if *format != "text" && *format != formatJSON {
+		return fmt.Errorf("unknown output format: %s", *format)
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	changes, err := client.WatchZone(ctx, args[0], *interval)
+	if err != nil {
+		return err
+	}
+
+	for change := range changes {
+		if change.Err != nil {
+			_, _ = fmt.Fprintln(a.stderr, "nodionctl: poll:", change.Err)
+			continue
+		}
+
+		err = writeChange(a.stdout, *format, recordChange{Time: change.Time, Type: change.Type, Record: change.Record})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeChange(w io.Writer, format string, change recordChange) error {
+	if format == formatJSON {
+		return json.NewEncoder(w).Encode(change)
+	}
+
+	_, err := fmt.Fprintf(w, "%s %-8s %s %s %q ttl=%d [%s]\n",
+		change.Time.Format(time.RFC3339), change.Type,
+		change.Record.RecordType, change.Record.Name, change.Record.Content, change.Record.TTL, change.Record.ID)
+
+	return err
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type syncBuffer struct {
+	mu sync.Mutex
+	sb strings.Builder
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sb.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sb.String()
+}
+
+func TestCLI_recordsWatch(t *testing.T) {
+	app, server, _ := setupCLI(t)
+
+	stdout := &syncBuffer{}
+	app.stdout = stdout
+
+	snapshots := [][]nodion.Record{
+		{{ID: "1", RecordType: "a", Name: "www", Content: "1.1.1.1", TTL: 60}},
+		{{ID: "1", RecordType: "a", Name: "www", Content: "1.1.1.1", TTL: 60}, {ID: "2", RecordType: "txt", Name: "@", Content: "foo", TTL: 60}},
+		{{ID: "2", RecordType: "txt", Name: "@", Content: "foo", TTL: 60}},
+	}
+
+	var mu sync.Mutex
+
+	polls := 0
+
+	server.HandleFunc("/dns_zones/"+zoneID+"/records", func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		records := snapshots[len(snapshots)-1]
+		if polls < len(snapshots) {
+			records = snapshots[polls]
+		}
+
+		polls++
+
+		_ = json.NewEncoder(rw).Encode(nodion.RecordsResponse{Records: records})
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- app.run(ctx, []string{"records", "watch", zoneID, "-interval", "10ms", "-format", "json"})
+	}()
+
+	require.Eventually(t, func() bool {
+		return strings.Count(stdout.String(), "\n") >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+
+	var changes []recordChange
+
+	for _, line := range lines {
+		var change recordChange
+
+		require.NoError(t, json.Unmarshal([]byte(line), &change))
+
+		changes = append(changes, change)
+	}
+
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, nodion.ChangeAdded, changes[0].Type)
+	assert.Equal(t, "2", changes[0].Record.ID)
+	assert.Equal(t, nodion.ChangeDeleted, changes[1].Type)
+	assert.Equal(t, "1", changes[1].Record.ID)
+}
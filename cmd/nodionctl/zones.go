@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nrdcg/nodion"
+)
+
+func (a cli) listZones(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones list")
+	name := fs.String("name", "", "filter on the exact zone name")
+	format := addOutputFlag(fs)
+
+	_, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	zones, err := client.GetZones(ctx, &nodion.ZonesFilter{Name: *name})
+	if err != nil {
+		return err
+	}
+
+	return writeZones(a.stdout, *format, zones)
+}
+
+func (a cli) createZone(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones create <name>")
+	format := addOutputFlag(fs)
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("zones create: expected a zone name")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	zone, err := client.CreateZone(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return writeZones(a.stdout, *format, []nodion.Zone{*zone})
+}
+
+func (a cli) deleteZone(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones delete <zone ID>")
+	yes := fs.Bool("yes", false, "confirm the deletion of the zone and all its records")
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("zones delete: expected a zone ID")
+	}
+
+	if !*yes {
+		return errors.New("zones delete: the deletion must be confirmed with -yes")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := client.DeleteZone(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("zone %s not deleted", args[0])
+	}
+
+	return nil
+}
+
+func (a cli) exportZone(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones export <zone ID>")
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("zones export: expected a zone ID")
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	zones, err := client.GetZones(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	zone, err := findZone(zones, args[0])
+	if err != nil {
+		return err
+	}
+
+	records, err := client.GetRecords(ctx, zone.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	export := nodion.Zone{Name: zone.Name}
+
+	for _, record := range records {
+		export.Records = append(export.Records, nodion.Record{
+			RecordType: record.RecordType,
+			Name:       record.Name,
+			Content:    record.Content,
+			TTL:        record.TTL,
+		})
+	}
+
+	return writeJSON(a.stdout, export)
+}
+
+func (a cli) importZone(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("zones import <file>")
+	zoneID := fs.String("zone", "", "the ID of the zone to import into (default: the zone named in the file, created if needed)")
+
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("zones import: expected a file (- for stdin)")
+	}
+
+	zone, err := a.readZone(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := resolveZone(ctx, client, *zoneID, zone.Name)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetRecords(ctx, target.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	var created []nodion.Record
+
+	for _, record := range zone.Records {
+		if containsRecord(existing, record) {
+			continue
+		}
+
+		r, err := client.CreateRecord(ctx, target.ID, record)
+		if err != nil {
+			return fmt.Errorf("create record %s %s: %w", record.RecordType, record.Name, err)
+		}
+
+		created = append(created, *r)
+	}
+
+	_, _ = fmt.Fprintf(a.stderr, "%d record(s) created in zone %s (%s)\n", len(created), target.Name, target.ID)
+
+	return nil
+}
+
+func (a cli) readZone(filename string) (*nodion.Zone, error) {
+	var r io.Reader = a.stdin
+
+	if filename != "-" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		defer func() { _ = file.Close() }()
+
+		r = file
+	}
+
+	zone := &nodion.Zone{}
+
+	err := json.NewDecoder(r).Decode(zone)
+	if err != nil {
+		return nil, fmt.Errorf("decode zone: %w", err)
+	}
+
+	return zone, nil
+}
+
+// resolveZone returns the zone with the ID, or (if the ID is empty) the zone with the name, created if needed.
+func resolveZone(ctx context.Context, client *nodion.Client, zoneID, name string) (*nodion.Zone, error) {
+	zone, err := lookupZone(ctx, client, zoneID, name)
+	if err != nil || zone != nil {
+		return zone, err
+	}
+
+	return client.CreateZone(ctx, name)
+}
+
+// lookupZone returns the zone with the ID, or (if the ID is empty) the zone with the name.
+// It returns nil if there is no zone with the name.
+func lookupZone(ctx context.Context, client *nodion.Client, zoneID, name string) (*nodion.Zone, error) {
+	if zoneID != "" {
+		zones, err := client.GetZones(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return findZone(zones, zoneID)
+	}
+
+	if name == "" {
+		return nil, errors.New("no zone ID and no zone name")
+	}
+
+	zones, err := client.GetZones(ctx, &nodion.ZonesFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zones) == 0 {
+		return nil, nil
+	}
+
+	return &zones[0], nil
+}
+
+func findZone(zones []nodion.Zone, zoneID string) (*nodion.Zone, error) {
+	for _, zone := range zones {
+		if zone.ID == zoneID {
+			return &zone, nil
+		}
+	}
+
+	return nil, fmt.Errorf("zone %s: %w", zoneID, nodion.ErrZoneNotFound)
+}
+
+func containsRecord(records []nodion.Record, record nodion.Record) bool {
+	for _, r := range records {
+		if r.RecordType == record.RecordType && r.Name == record.Name && r.Content == record.Content {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,37 @@
+package nodion
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// JSONCodec encodes and decodes the JSON payloads.
+// It allows to replace encoding/json by a faster implementation (ex: sonic, json-iterator).
+type JSONCodec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// WithJSONCodec sets the JSON codec used to encode the requests and decode the responses.
+// The default codec is encoding/json.
+func WithJSONCodec(codec JSONCodec) Option {
+	return func(c *Client) error {
+		if codec == nil {
+			return errors.New("JSON codec is required")
+		}
+
+		c.codec = codec
+
+		return nil
+	}
+}
+
+type stdJSONCodec struct{}
+
+func (stdJSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,48 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingCodec struct {
+	marshal   int
+	unmarshal int
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshal++
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshal++
+	return json.Unmarshal(data, v)
+}
+
+func TestWithJSONCodec(t *testing.T) {
+	codec := &countingCodec{}
+
+	client := setupTest(t, "/dns_zones/xxx/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")(rw, req)
+			return
+		}
+
+		readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")(rw, req)
+	}, WithJSONCodec(codec))
+
+	_, err := client.CreateRecord(context.Background(), "xxx", Record{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60})
+	require.NoError(t, err)
+
+	err = client.WalkRecords(context.Background(), "xxx", nil, func(Record) error { return nil })
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, codec.marshal)
+	assert.Equal(t, 6, codec.unmarshal)
+}
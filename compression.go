@@ -0,0 +1,72 @@
+package nodion
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WithCompressionDisabled disables the transparent gzip compression of the responses
+// (useful when debugging with a proxy).
+func WithCompressionDisabled() Option {
+	return func(c *Client) error {
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tr.DisableCompression = true
+
+		return nil
+	}
+}
+
+// WithRequestCompression enables the gzip compression of the request bodies.
+// It's disabled by default because the API may not support compressed request bodies.
+func WithRequestCompression() Option {
+	return func(c *Client) error {
+		c.requestCompression = true
+		return nil
+	}
+}
+
+func compressBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		return fmt.Errorf("read request body: %w", err)
+	}
+
+	_ = req.Body.Close()
+
+	buf := &bytes.Buffer{}
+
+	zw := gzip.NewWriter(buf)
+
+	_, err = zw.Write(raw)
+	if err != nil {
+		return fmt.Errorf("compress request body: %w", err)
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return fmt.Errorf("compress request body: %w", err)
+	}
+
+	compressed := buf.Bytes()
+
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+
+	req.Header.Set("Content-Encoding", "gzip")
+
+	return nil
+}
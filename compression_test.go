@@ -0,0 +1,41 @@
+package nodion
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRecord_requestCompression(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+
+		zr, err := gzip.NewReader(req.Body)
+		require.NoError(t, err)
+
+		var record Record
+		require.NoError(t, json.NewDecoder(zr).Decode(&record))
+
+		assert.Equal(t, "www", record.Name)
+
+		readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")(rw, req)
+	}, WithRequestCompression())
+
+	_, err := client.CreateRecord(context.Background(), "xxx", Record{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60})
+	require.NoError(t, err)
+}
+
+func TestWithCompressionDisabled(t *testing.T) {
+	client, err := NewClient("secret", WithCompressionDisabled())
+	require.NoError(t, err)
+
+	tr, err := client.transport()
+	require.NoError(t, err)
+
+	assert.True(t, tr.DisableCompression)
+}
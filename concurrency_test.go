@@ -0,0 +1,90 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClient_concurrency exercises the client from many goroutines, it's meant to be run with the race detector.
+func TestClient_concurrency(t *testing.T) {
+	var mutations atomic.Int32
+
+	client, api := setupFakeAPI(t, nil,
+		WithCache(time.Minute),
+		WithRecycleBin(),
+		WithTagStore(NewMemoryTagStore()),
+		WithMutationHook(func(_ context.Context, _ Mutation) { mutations.Add(1) }),
+		WithCircuitBreaker(100, time.Second),
+		WithRetry(1, time.Millisecond),
+		WithRetryBudget(100, 0.1),
+	)
+
+	const n = 20
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, n)
+
+	for i := 0; i < n; i++ {
+		i := i
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			record, err := client.CreateRecord(ctx, "zzz", Record{
+				RecordType: TypeTXT,
+				Name:       fmt.Sprintf("r%d", i),
+				Content:    "foo",
+				TTL:        60,
+				Tags:       map[string]string{"owner": "test"},
+			})
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = client.GetRecords(ctx, "zzz", &RecordsFilter{Tags: map[string]string{"owner": "test"}})
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = client.GetZones(ctx, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, _, _ = client.RateLimit()
+			_ = client.BreakerState()
+			_ = client.RetryStats()
+
+			_, err = client.DeleteRecord(ctx, "zzz", record.ID)
+			if err != nil {
+				errs <- err
+				return
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	assert.Empty(t, api.snapshot())
+	assert.EqualValues(t, 2*n, mutations.Load())
+}
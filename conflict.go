@@ -0,0 +1,63 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrRecordConflict is returned when a record can't coexist with the existing records (see WithCNAMEConflictCheck).
+var ErrRecordConflict = errors.New("record conflict")
+
+// WithCNAMEConflictCheck checks, before the creation of a record,
+// that a CNAME record is not created at a name with other records, and that no record is created at a name with a CNAME record.
+// The conflicts are returned as ErrRecordConflict.
+func WithCNAMEConflictCheck() Option {
+	return func(c *Client) error {
+		c.cnameConflictCheck = true
+		return nil
+	}
+}
+
+func (c Client) checkCNAMEConflict(ctx context.Context, zoneID string, record Record) error {
+	if !c.cnameConflictCheck {
+		return nil
+	}
+
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return err
+	}
+
+	name := RelativeName(record.Name, zone.Name)
+
+	var conflicts []string
+
+	for _, existing := range records {
+		if RelativeName(existing.Name, zone.Name) != name {
+			continue
+		}
+
+		if record.RecordType == TypeCNAME || existing.RecordType == TypeCNAME {
+			conflicts = append(conflicts, fmt.Sprintf("%s %q", existing.RecordType, existing.Content))
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	if record.RecordType == TypeCNAME {
+		return fmt.Errorf("%w: the CNAME record %s can't coexist with the existing records: %s",
+			ErrRecordConflict, name, strings.Join(conflicts, ", "))
+	}
+
+	return fmt.Errorf("%w: the %s record %s can't coexist with the existing CNAME record: %s",
+		ErrRecordConflict, record.RecordType, name, strings.Join(conflicts, ", "))
+}
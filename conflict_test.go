@@ -0,0 +1,34 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRecord_cnameConflict(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		{RecordType: TypeCNAME, Name: "blog", Content: "example.org", TTL: 60},
+	}, WithCNAMEConflictCheck())
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeCNAME, Name: "www.example.com", Content: "example.org", TTL: 60})
+	require.ErrorIs(t, err, ErrRecordConflict)
+	require.EqualError(t, err, `record conflict: the CNAME record www can't coexist with the existing records: a "1.1.1.1"`)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeTXT, Name: "blog", Content: "foo", TTL: 60})
+	require.EqualError(t, err, `record conflict: the txt record blog can't coexist with the existing CNAME record: cname "example.org"`)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeCNAME, Name: "blog", Content: "example.net", TTL: 60})
+	require.ErrorIs(t, err, ErrRecordConflict)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "2.2.2.2", TTL: 60})
+	require.NoError(t, err)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeCNAME, Name: "shop", Content: "example.org", TTL: 60})
+	require.NoError(t, err)
+
+	assert.Len(t, api.snapshot(), 4)
+}
@@ -0,0 +1,93 @@
+// Package convert converts the records of a zone to and from the formats of other DNS tools
+// (octoDNS zone files and dnscontrol configurations).
+//
+// The contents of the records follow the zone file syntax:
+// `<preference> <exchange>` for MX records and `<priority> <weight> <port> <target>` for SRV records.
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nrdcg/nodion"
+)
+
+// DefaultTTL is the TTL of the records parsed without TTL.
+const DefaultTTL = 3600
+
+type mxValue struct {
+	Preference int
+	Exchange   string
+}
+
+type srvValue struct {
+	Priority int
+	Weight   int
+	Port     int
+	Target   string
+}
+
+func parseMX(content string) (mxValue, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 2 {
+		return mxValue{}, fmt.Errorf("invalid MX content: %q", content)
+	}
+
+	preference, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return mxValue{}, fmt.Errorf("invalid MX preference: %q", content)
+	}
+
+	return mxValue{Preference: preference, Exchange: fields[1]}, nil
+}
+
+func (v mxValue) content() string {
+	return fmt.Sprintf("%d %s", v.Preference, trimDot(v.Exchange))
+}
+
+func parseSRV(content string) (srvValue, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 4 {
+		return srvValue{}, fmt.Errorf("invalid SRV content: %q", content)
+	}
+
+	var numbers [3]int
+
+	for i := range numbers {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return srvValue{}, fmt.Errorf("invalid SRV content: %q", content)
+		}
+
+		numbers[i] = n
+	}
+
+	return srvValue{Priority: numbers[0], Weight: numbers[1], Port: numbers[2], Target: fields[3]}, nil
+}
+
+func (v srvValue) content() string {
+	return fmt.Sprintf("%d %d %d %s", v.Priority, v.Weight, v.Port, trimDot(v.Target))
+}
+
+// isHostname reports whether the content of the record type is a hostname (FQDN with a trailing dot in the other formats).
+func isHostname(recordType nodion.RecordType) bool {
+	switch recordType {
+	case nodion.TypeCNAME, nodion.TypeALIAS, nodion.TypeNS, nodion.TypePTR:
+		return true
+	default:
+		return false
+	}
+}
+
+func withDot(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+func trimDot(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
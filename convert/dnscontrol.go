@@ -0,0 +1,209 @@
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nrdcg/nodion"
+)
+
+var (
+	dnscontrolDomain = regexp.MustCompile(`(?m)^\s*D\(\s*("(?:[^"\\]|\\.)*")`)
+	dnscontrolRecord = regexp.MustCompile(`(?m)^\s*(A|AAAA|ALIAS|CNAME|MX|NS|PTR|SRV|TXT)\((.*)\),?\s*$`)
+)
+
+// ToDNSControl encodes the records of a zone to a dnscontrol configuration (dnsconfig.js).
+// The registrar and the provider are named REG_NONE and DSP_NODION.
+func ToDNSControl(zoneName string, records []nodion.Record) (string, error) {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "var REG_NONE = NewRegistrar(\"none\");\nvar DSP_NODION = NewDnsProvider(\"nodion\");\n\n")
+	_, _ = fmt.Fprintf(&b, "D(%s, REG_NONE, DnsProvider(DSP_NODION),\n", strconv.Quote(trimDot(zoneName)))
+
+	for i, record := range records {
+		if !record.RecordType.Valid() {
+			return "", fmt.Errorf("unsupported record type: %s", record.RecordType)
+		}
+
+		args, err := dnscontrolArgs(record)
+		if err != nil {
+			return "", fmt.Errorf("record %s (%s): %w", record.Name, record.RecordType, err)
+		}
+
+		sep := ","
+		if i == len(records)-1 {
+			sep = ""
+		}
+
+		_, _ = fmt.Fprintf(&b, "\t%s(%s, %s, TTL(%d))%s\n",
+			strings.ToUpper(string(record.RecordType)), strconv.Quote(nodion.RelativeName(record.Name, zoneName)), args, record.TTL, sep)
+	}
+
+	b.WriteString(");\n")
+
+	return b.String(), nil
+}
+
+// FromDNSControl decodes a dnscontrol configuration (dnsconfig.js) with a single domain to records.
+// Only the records written one per line with literal arguments (as produced by ToDNSControl) are supported.
+func FromDNSControl(src string) (string, []nodion.Record, error) {
+	domain := dnscontrolDomain.FindStringSubmatch(src)
+	if domain == nil {
+		return "", nil, errors.New("no domain (D) found")
+	}
+
+	zoneName, err := strconv.Unquote(domain[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid domain name: %w", err)
+	}
+
+	var records []nodion.Record
+
+	for _, match := range dnscontrolRecord.FindAllStringSubmatch(src, -1) {
+		record, err := parseDNSControlRecord(nodion.RecordType(strings.ToLower(match[1])), match[2])
+		if err != nil {
+			return "", nil, fmt.Errorf("%s(%s): %w", match[1], match[2], err)
+		}
+
+		records = append(records, record)
+	}
+
+	return zoneName, records, nil
+}
+
+func dnscontrolArgs(record nodion.Record) (string, error) {
+	switch {
+	case record.RecordType == nodion.TypeMX:
+		mx, err := parseMX(record.Content)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%d, %s", mx.Preference, strconv.Quote(withDot(mx.Exchange))), nil
+
+	case record.RecordType == nodion.TypeSRV:
+		srv, err := parseSRV(record.Content)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%d, %d, %d, %s", srv.Priority, srv.Weight, srv.Port, strconv.Quote(withDot(srv.Target))), nil
+
+	case isHostname(record.RecordType):
+		return strconv.Quote(withDot(record.Content)), nil
+
+	default:
+		return strconv.Quote(record.Content), nil
+	}
+}
+
+func parseDNSControlRecord(recordType nodion.RecordType, rawArgs string) (nodion.Record, error) {
+	args, err := splitArgs(rawArgs)
+	if err != nil {
+		return nodion.Record{}, err
+	}
+
+	record := nodion.Record{RecordType: recordType, TTL: DefaultTTL}
+
+	var values []string
+
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "TTL(") && strings.HasSuffix(arg, ")"):
+			ttl, err := strconv.Atoi(strings.TrimSpace(arg[len("TTL(") : len(arg)-1]))
+			if err != nil {
+				return nodion.Record{}, fmt.Errorf("invalid TTL: %s", arg)
+			}
+
+			record.TTL = ttl
+
+		case strings.HasPrefix(arg, `"`):
+			value, err := strconv.Unquote(arg)
+			if err != nil {
+				return nodion.Record{}, fmt.Errorf("invalid string: %s", arg)
+			}
+
+			values = append(values, value)
+
+		case isNumber(arg):
+			values = append(values, arg)
+
+		default:
+			return nodion.Record{}, fmt.Errorf("unsupported argument: %s", arg)
+		}
+	}
+
+	expected := map[nodion.RecordType]int{nodion.TypeMX: 3, nodion.TypeSRV: 5}[recordType]
+	if expected == 0 {
+		expected = 2
+	}
+
+	if len(values) != expected {
+		return nodion.Record{}, fmt.Errorf("expected %d arguments, got %d", expected, len(values))
+	}
+
+	record.Name = values[0]
+
+	switch {
+	case recordType == nodion.TypeMX || recordType == nodion.TypeSRV:
+		values[len(values)-1] = trimDot(values[len(values)-1])
+		record.Content = strings.Join(values[1:], " ")
+	case isHostname(recordType):
+		record.Content = trimDot(values[1])
+	default:
+		record.Content = values[1]
+	}
+
+	return record, nil
+}
+
+// splitArgs splits the arguments of a call on the commas outside of the strings and the parentheses.
+func splitArgs(s string) ([]string, error) {
+	var args []string
+
+	var current strings.Builder
+
+	depth := 0
+	inString := false
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case inString && r == '\\':
+			escaped = true
+		case r == '"':
+			inString = !inString
+		case !inString && r == '(':
+			depth++
+		case !inString && r == ')':
+			depth--
+		case !inString && depth == 0 && r == ',':
+			args = append(args, strings.TrimSpace(current.String()))
+			current.Reset()
+
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	if inString || depth != 0 {
+		return nil, errors.New("unbalanced arguments")
+	}
+
+	if last := strings.TrimSpace(current.String()); last != "" {
+		args = append(args, last)
+	}
+
+	return args, nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,62 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToDNSControl(t *testing.T) {
+	src, err := ToDNSControl("example.com", testRecords())
+	require.NoError(t, err)
+
+	expected := `var REG_NONE = NewRegistrar("none");
+var DSP_NODION = NewDnsProvider("nodion");
+
+D("example.com", REG_NONE, DnsProvider(DSP_NODION),
+	A("@", "1.1.1.1", TTL(3600)),
+	A("@", "2.2.2.2", TTL(3600)),
+	MX("@", 10, "mail.example.com.", TTL(3600)),
+	TXT("@", "v=spf1 mx -all; foo", TTL(300)),
+	SRV("_sip._tcp", 10, 60, 5060, "sip.example.com.", TTL(3600)),
+	CNAME("www", "example.com.", TTL(3600))
+);
+`
+
+	assert.Equal(t, expected, src)
+
+	zoneName, records, err := FromDNSControl(src)
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", zoneName)
+	assert.Equal(t, testRecords(), records)
+}
+
+func TestFromDNSControl(t *testing.T) {
+	src := `D("example.org", REG_NONE, DnsProvider(DSP_X),
+    A("www", "1.1.1.1"),
+    TXT("@", "a \"quoted\", text", TTL(60)),
+END);`
+
+	zoneName, records, err := FromDNSControl(src)
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.org", zoneName)
+
+	expected := []nodion.Record{
+		{RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: DefaultTTL},
+		{RecordType: nodion.TypeTXT, Name: "@", Content: `a "quoted", text`, TTL: 60},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestFromDNSControl_errors(t *testing.T) {
+	_, _, err := FromDNSControl(`A("www", "1.1.1.1")`)
+	require.EqualError(t, err, "no domain (D) found")
+
+	_, _, err = FromDNSControl("D(\"example.com\",\n  MX(\"@\", \"mail.example.com.\")\n);")
+	require.EqualError(t, err, `MX("@", "mail.example.com."): expected 3 arguments, got 2`)
+}
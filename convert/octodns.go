@@ -0,0 +1,278 @@
+package convert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nrdcg/nodion"
+	"gopkg.in/yaml.v3"
+)
+
+type octoRecord struct {
+	Type   string `yaml:"type"`
+	TTL    int    `yaml:"ttl,omitempty"`
+	Value  any    `yaml:"value,omitempty"`
+	Values []any  `yaml:"values,omitempty"`
+}
+
+type octoMX struct {
+	Exchange   string `yaml:"exchange"`
+	Preference int    `yaml:"preference"`
+}
+
+type octoSRV struct {
+	Port     int    `yaml:"port"`
+	Priority int    `yaml:"priority"`
+	Target   string `yaml:"target"`
+	Weight   int    `yaml:"weight"`
+}
+
+// octoRecords is the list of the records of a name: octoDNS accepts a single record or a list.
+type octoRecords []octoRecord
+
+func (r *octoRecords) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		var record octoRecord
+
+		err := node.Decode(&record)
+		if err != nil {
+			return err
+		}
+
+		*r = octoRecords{record}
+
+		return nil
+	}
+
+	var records []octoRecord
+
+	err := node.Decode(&records)
+	if err != nil {
+		return err
+	}
+
+	*r = records
+
+	return nil
+}
+
+// ToOctoDNS encodes the records of a zone to an octoDNS zone file (YAML).
+// The records of a name and a type share a single TTL in octoDNS: the lowest TTL is used.
+func ToOctoDNS(zoneName string, records []nodion.Record) ([]byte, error) {
+	type group struct {
+		name  string
+		rtype nodion.RecordType
+	}
+
+	groups := map[group]*octoRecord{}
+
+	var order []group
+
+	for _, record := range records {
+		if !record.RecordType.Valid() {
+			return nil, fmt.Errorf("unsupported record type: %s", record.RecordType)
+		}
+
+		name := nodion.RelativeName(record.Name, zoneName)
+		if name == "@" {
+			name = ""
+		}
+
+		value, err := octoValue(record)
+		if err != nil {
+			return nil, fmt.Errorf("record %s (%s): %w", record.Name, record.RecordType, err)
+		}
+
+		key := group{name: name, rtype: record.RecordType}
+
+		entry, ok := groups[key]
+		if !ok {
+			entry = &octoRecord{Type: strings.ToUpper(string(record.RecordType)), TTL: record.TTL}
+			groups[key] = entry
+			order = append(order, key)
+		}
+
+		if record.TTL < entry.TTL {
+			entry.TTL = record.TTL
+		}
+
+		entry.Values = append(entry.Values, value)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].name != order[j].name {
+			return order[i].name < order[j].name
+		}
+
+		return order[i].rtype < order[j].rtype
+	})
+
+	zone := map[string][]octoRecord{}
+
+	for _, key := range order {
+		entry := *groups[key]
+
+		if len(entry.Values) == 1 {
+			entry.Value = entry.Values[0]
+			entry.Values = nil
+		}
+
+		zone[key.name] = append(zone[key.name], entry)
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("---\n")
+
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+
+	err := enc.Encode(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// FromOctoDNS decodes an octoDNS zone file (YAML) to records.
+func FromOctoDNS(data []byte) ([]nodion.Record, error) {
+	var zone map[string]octoRecords
+
+	err := yaml.Unmarshal(data, &zone)
+	if err != nil {
+		return nil, fmt.Errorf("decode octoDNS zone: %w", err)
+	}
+
+	names := make([]string, 0, len(zone))
+	for name := range zone {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var records []nodion.Record
+
+	for _, name := range names {
+		recordName := name
+		if recordName == "" {
+			recordName = "@"
+		}
+
+		for _, entry := range zone[name] {
+			recordType := nodion.RecordType(strings.ToLower(entry.Type))
+			if !recordType.Valid() {
+				return nil, fmt.Errorf("%q: unsupported record type: %s", name, entry.Type)
+			}
+
+			ttl := entry.TTL
+			if ttl == 0 {
+				ttl = DefaultTTL
+			}
+
+			values := entry.Values
+			if entry.Value != nil {
+				values = append([]any{entry.Value}, values...)
+			}
+
+			if len(values) == 0 {
+				return nil, fmt.Errorf("%q (%s): no value", name, entry.Type)
+			}
+
+			for _, value := range values {
+				content, err := fromOctoValue(recordType, value)
+				if err != nil {
+					return nil, fmt.Errorf("%q (%s): %w", name, entry.Type, err)
+				}
+
+				records = append(records, nodion.Record{RecordType: recordType, Name: recordName, Content: content, TTL: ttl})
+			}
+		}
+	}
+
+	return records, nil
+}
+
+func octoValue(record nodion.Record) (any, error) {
+	switch {
+	case record.RecordType == nodion.TypeMX:
+		mx, err := parseMX(record.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		return octoMX{Exchange: withDot(mx.Exchange), Preference: mx.Preference}, nil
+
+	case record.RecordType == nodion.TypeSRV:
+		srv, err := parseSRV(record.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		return octoSRV{Port: srv.Port, Priority: srv.Priority, Target: withDot(srv.Target), Weight: srv.Weight}, nil
+
+	case record.RecordType == nodion.TypeTXT:
+		// octoDNS requires the semicolons to be escaped.
+		return strings.ReplaceAll(record.Content, ";", `\;`), nil
+
+	case isHostname(record.RecordType):
+		return withDot(record.Content), nil
+
+	default:
+		return record.Content, nil
+	}
+}
+
+func fromOctoValue(recordType nodion.RecordType, value any) (string, error) {
+	switch recordType {
+	case nodion.TypeMX:
+		var mx octoMX
+
+		err := remarshal(value, &mx)
+		if err != nil {
+			return "", err
+		}
+
+		return mxValue{Preference: mx.Preference, Exchange: mx.Exchange}.content(), nil
+
+	case nodion.TypeSRV:
+		var srv octoSRV
+
+		err := remarshal(value, &srv)
+		if err != nil {
+			return "", err
+		}
+
+		return srvValue{Priority: srv.Priority, Weight: srv.Weight, Port: srv.Port, Target: srv.Target}.content(), nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string value, got %T", value)
+	}
+
+	switch {
+	case recordType == nodion.TypeTXT:
+		return strings.ReplaceAll(s, `\;`, ";"), nil
+	case isHostname(recordType):
+		return trimDot(s), nil
+	default:
+		return s, nil
+	}
+}
+
+// remarshal decodes a generic YAML value to a struct.
+func remarshal(value, target any) error {
+	if _, ok := value.(map[string]any); !ok {
+		return errors.New("expected a map value")
+	}
+
+	raw, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(raw, target)
+}
@@ -0,0 +1,89 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecords() []nodion.Record {
+	return []nodion.Record{
+		{RecordType: nodion.TypeA, Name: "@", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: nodion.TypeA, Name: "@", Content: "2.2.2.2", TTL: 3600},
+		{RecordType: nodion.TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 3600},
+		{RecordType: nodion.TypeTXT, Name: "@", Content: "v=spf1 mx -all; foo", TTL: 300},
+		{RecordType: nodion.TypeSRV, Name: "_sip._tcp", Content: "10 60 5060 sip.example.com", TTL: 3600},
+		{RecordType: nodion.TypeCNAME, Name: "www", Content: "example.com", TTL: 3600},
+	}
+}
+
+func TestToOctoDNS(t *testing.T) {
+	data, err := ToOctoDNS("example.com", testRecords())
+	require.NoError(t, err)
+
+	expected := `---
+"":
+  - type: A
+    ttl: 3600
+    values:
+      - 1.1.1.1
+      - 2.2.2.2
+  - type: MX
+    ttl: 3600
+    value:
+      exchange: mail.example.com.
+      preference: 10
+  - type: TXT
+    ttl: 300
+    value: v=spf1 mx -all\; foo
+_sip._tcp:
+  - type: SRV
+    ttl: 3600
+    value:
+      port: 5060
+      priority: 10
+      target: sip.example.com.
+      weight: 60
+www:
+  - type: CNAME
+    ttl: 3600
+    value: example.com.
+`
+
+	assert.Equal(t, expected, string(data))
+
+	records, err := FromOctoDNS(data)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, testRecords(), records)
+}
+
+func TestFromOctoDNS(t *testing.T) {
+	data := `
+'':
+  type: A
+  value: 1.1.1.1
+www:
+  - type: AAAA
+    ttl: 60
+    values: ["::1", "::2"]
+`
+
+	records, err := FromOctoDNS([]byte(data))
+	require.NoError(t, err)
+
+	expected := []nodion.Record{
+		{RecordType: nodion.TypeA, Name: "@", Content: "1.1.1.1", TTL: DefaultTTL},
+		{RecordType: nodion.TypeAAAA, Name: "www", Content: "::1", TTL: 60},
+		{RecordType: nodion.TypeAAAA, Name: "www", Content: "::2", TTL: 60},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestFromOctoDNS_unsupported(t *testing.T) {
+	_, err := FromOctoDNS([]byte("'':\n  type: CAA\n  value: {flags: 0, tag: issue, value: letsencrypt.org}\n"))
+	require.EqualError(t, err, `"": unsupported record type: CAA`)
+}
@@ -0,0 +1,78 @@
+package nodion
+
+// DeepCopyInto copies the receiver into out.
+func (z *Zone) DeepCopyInto(out *Zone) {
+	*out = *z
+
+	if z.Records != nil {
+		out.Records = make([]Record, len(z.Records))
+		for i := range z.Records {
+			z.Records[i].DeepCopyInto(&out.Records[i])
+		}
+	}
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (z *Zone) DeepCopy() *Zone {
+	if z == nil {
+		return nil
+	}
+
+	out := new(Zone)
+	z.DeepCopyInto(out)
+
+	return out
+}
+
+// Equal reports whether two zones are equal, ignoring the timestamps (of the zones and the records).
+// The records are compared in order.
+func (z Zone) Equal(other Zone) bool {
+	if z.ID != other.ID || z.Name != other.Name || len(z.Records) != len(other.Records) {
+		return false
+	}
+
+	for i := range z.Records {
+		if !z.Records[i].Equal(other.Records[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepCopyInto copies the receiver into out.
+func (r *Record) DeepCopyInto(out *Record) {
+	*out = *r
+
+	if r.Tags != nil {
+		out.Tags = make(map[string]string, len(r.Tags))
+		for k, v := range r.Tags {
+			out.Tags[k] = v
+		}
+	}
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (r *Record) DeepCopy() *Record {
+	if r == nil {
+		return nil
+	}
+
+	out := new(Record)
+	r.DeepCopyInto(out)
+
+	return out
+}
+
+// Equal reports whether two records are equal, ignoring the timestamps.
+// A nil and an empty set of tags are equal.
+func (r Record) Equal(other Record) bool {
+	return r.ID == other.ID &&
+		r.RecordType == other.RecordType &&
+		r.Name == other.Name &&
+		r.Content == other.Content &&
+		r.TTL == other.TTL &&
+		r.ZoneID == other.ZoneID &&
+		len(r.Tags) == len(other.Tags) &&
+		hasTags(other.Tags, r.Tags)
+}
@@ -0,0 +1,48 @@
+package nodion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZone_DeepCopy(t *testing.T) {
+	zone := &Zone{
+		ID:   "zzz",
+		Name: "example.com",
+		Records: []Record{
+			{ID: "id1", RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600, Tags: map[string]string{"env": "prod"}},
+		},
+	}
+
+	out := zone.DeepCopy()
+	assert.True(t, zone.Equal(*out))
+
+	out.Records[0].Tags["env"] = "dev"
+	out.Records[0].Content = "192.0.2.2"
+
+	assert.Equal(t, "prod", zone.Records[0].Tags["env"])
+	assert.Equal(t, "192.0.2.1", zone.Records[0].Content)
+	assert.False(t, zone.Equal(*out))
+
+	assert.Nil(t, (*Zone)(nil).DeepCopy())
+}
+
+func TestRecord_Equal(t *testing.T) {
+	record := Record{ID: "id1", RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600}
+
+	other := record
+	other.CreatedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	other.UpdatedAt = time.Now()
+	other.Tags = map[string]string{}
+
+	assert.True(t, record.Equal(other))
+
+	other.TTL = 60
+	assert.False(t, record.Equal(other))
+
+	other = record
+	other.Tags = map[string]string{"env": "prod"}
+	assert.False(t, record.Equal(other))
+}
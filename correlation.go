@@ -0,0 +1,70 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// DefaultCorrelationIDHeader is the default header of the correlation IDs (see WithCorrelationIDHeader).
+const DefaultCorrelationIDHeader = "X-Correlation-ID"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context carrying a correlation ID,
+// sent with the requests made with this context (see WithCorrelationIDHeader).
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// WithCorrelationIDHeader sends the correlation ID of the context of each request in a header
+// (DefaultCorrelationIDHeader if empty).
+// The ID is the value of the context for key: a string or a fmt.Stringer.
+// If key is nil, the ID is the one set by WithCorrelationID.
+// It allows to use the IDs of an existing tracing or logging system.
+func WithCorrelationIDHeader(header string, key any) Option {
+	return func(c *Client) error {
+		if header == "" {
+			header = DefaultCorrelationIDHeader
+		}
+
+		if key == nil {
+			key = correlationIDKey{}
+		}
+
+		if !reflect.TypeOf(key).Comparable() {
+			return errors.New("the correlation ID key must be comparable")
+		}
+
+		c.correlation = &correlation{header: http.CanonicalHeaderKey(header), key: key}
+
+		return nil
+	}
+}
+
+type correlation struct {
+	header string
+	key    any
+}
+
+// apply sets the correlation ID header of a request.
+func (r *correlation) apply(req *http.Request) {
+	if r == nil {
+		return
+	}
+
+	var id string
+
+	switch value := req.Context().Value(r.key).(type) {
+	case string:
+		id = value
+	case fmt.Stringer:
+		id = value.String()
+	}
+
+	if id != "" {
+		req.Header.Set(r.header, id)
+	}
+}
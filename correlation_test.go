@@ -0,0 +1,57 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type traceKey struct{}
+
+type traceID string
+
+func (t traceID) String() string {
+	return "trace-" + string(t)
+}
+
+func TestWithCorrelationIDHeader(t *testing.T) {
+	client, mux := setupTestMux(t, WithCorrelationIDHeader("", nil))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	_, err := client.GetZones(WithCorrelationID(context.Background(), "abc"), nil)
+	require.NoError(t, err)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	requests := mux.Requests()
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, "abc", requests[0].Header.Get(DefaultCorrelationIDHeader))
+	assert.Empty(t, requests[1].Header.Values(DefaultCorrelationIDHeader))
+}
+
+func TestWithCorrelationIDHeader_key(t *testing.T) {
+	client, mux := setupTestMux(t, WithCorrelationIDHeader("x-request-id", traceKey{}))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	ctx := context.WithValue(context.Background(), traceKey{}, traceID("123"))
+
+	_, err := client.GetZones(ctx, nil)
+	require.NoError(t, err)
+
+	requests := mux.Requests()
+	require.Len(t, requests, 1)
+
+	assert.Equal(t, "trace-123", requests[0].Header.Get("X-Request-Id"))
+}
+
+func TestWithCorrelationIDHeader_invalidKey(t *testing.T) {
+	_, err := NewClient("secret", WithCorrelationIDHeader("", []string{"key"}))
+	require.EqualError(t, err, "the correlation ID key must be comparable")
+}
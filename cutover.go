@@ -0,0 +1,183 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+)
+
+// CutoverOptions are the options of Cutover.
+type CutoverOptions struct {
+	// PreCutoverTTL lowers the TTL of the records to flip before the cutover (if greater than 0):
+	// Cutover waits for the expiration of the previous TTLs (the highest one) before flipping the records,
+	// so the old targets are not cached for long by the resolvers after the cutover.
+	PreCutoverTTL int
+
+	// Wait waits for the expiration of the previous TTLs (default: sleeps until the context is done).
+	Wait func(ctx context.Context, d time.Duration) error
+
+	// TTL is the TTL of the flipped records (default: the original TTL of each record).
+	TTL int
+}
+
+// Cutover flips the A, AAAA, and CNAME records of a zone from old targets to new ones, e.g. for a blue/green deployment.
+// The keys of the mapping are the old targets, and the values the new targets.
+// The targets are compared as with Record.EquivalentTo.
+// The records are flipped with a ChangeSet: if a change fails, the applied changes are rolled back.
+// The lowered TTLs (see CutoverOptions.PreCutoverTTL) are not restored on failure.
+// It returns the flipped records.
+func (c Client) Cutover(ctx context.Context, zoneID string, mapping map[string]string, opts CutoverOptions) ([]Record, error) {
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var toFlip []Record
+
+	var targets []string
+
+	var ttls []int
+
+	for _, record := range records {
+		target, ok := cutoverTarget(record, mapping)
+		if !ok {
+			continue
+		}
+
+		err = checkCutoverTarget(record.RecordType, target)
+		if err != nil {
+			return nil, fmt.Errorf("record %s (%s): %w", record.Name, record.RecordType, err)
+		}
+
+		toFlip = append(toFlip, record)
+		targets = append(targets, target)
+		ttls = append(ttls, record.TTL)
+	}
+
+	if len(toFlip) == 0 {
+		return nil, errors.New("no record matches the cutover mapping")
+	}
+
+	if opts.PreCutoverTTL > 0 {
+		toFlip, err = c.lowerCutoverTTLs(ctx, zoneID, toFlip, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changeSet := c.BeginChangeSet(zoneID)
+
+	for i, record := range toFlip {
+		ttl := opts.TTL
+		if ttl == 0 {
+			ttl = ttls[i]
+		}
+
+		changeSet.Update(record, Record{
+			RecordType: record.RecordType,
+			Name:       record.Name,
+			Content:    targets[i],
+			TTL:        ttl,
+		})
+	}
+
+	return changeSet.Commit(ctx)
+}
+
+// lowerCutoverTTLs lowers the TTLs of the records, and waits for the expiration of the previous TTLs.
+// The records with a TTL already lower than the pre-cutover TTL are unchanged.
+// It returns the records with the lowered TTLs (in the same order).
+func (c Client) lowerCutoverTTLs(ctx context.Context, zoneID string, records []Record, opts CutoverOptions) ([]Record, error) {
+	changeSet := c.BeginChangeSet(zoneID)
+
+	maxTTL := 0
+
+	var changed []int
+
+	for i, record := range records {
+		if record.TTL <= opts.PreCutoverTTL {
+			continue
+		}
+
+		maxTTL = max(maxTTL, record.TTL)
+		changed = append(changed, i)
+
+		changeSet.Update(record, Record{RecordType: record.RecordType, Name: record.Name, Content: record.Content, TTL: opts.PreCutoverTTL})
+	}
+
+	if len(changed) == 0 {
+		return records, nil
+	}
+
+	created, err := changeSet.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lower TTLs: %w", err)
+	}
+
+	lowered := make([]Record, len(records))
+	copy(lowered, records)
+
+	for j, i := range changed {
+		lowered[i] = created[j]
+	}
+
+	wait := opts.Wait
+	if wait == nil {
+		wait = sleep
+	}
+
+	err = wait(ctx, time.Duration(maxTTL)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("wait for the expiration of the TTLs: %w", err)
+	}
+
+	return lowered, nil
+}
+
+func cutoverTarget(record Record, mapping map[string]string) (string, bool) {
+	switch record.RecordType {
+	case TypeA, TypeAAAA, TypeCNAME:
+	default:
+		return "", false
+	}
+
+	content := normalizeContent(record.RecordType, record.Content)
+
+	for old, target := range mapping {
+		if normalizeContent(record.RecordType, old) == content {
+			return target, true
+		}
+	}
+
+	return "", false
+}
+
+func checkCutoverTarget(recordType RecordType, target string) error {
+	addr, err := netip.ParseAddr(target)
+
+	switch {
+	case recordType == TypeA && (err != nil || !addr.Is4()):
+		return fmt.Errorf("the target %s is not an IPv4 address", target)
+	case recordType == TypeAAAA && (err != nil || !addr.Is6()):
+		return fmt.Errorf("the target %s is not an IPv6 address", target)
+	case recordType == TypeCNAME && (err == nil || target == ""):
+		return fmt.Errorf("the target %q is not a hostname", target)
+	default:
+		return nil
+	}
+}
+
+// sleep waits for a duration, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
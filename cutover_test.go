@@ -0,0 +1,108 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Cutover(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "@", Content: "192.0.2.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 600},
+		{RecordType: TypeAAAA, Name: "www", Content: "2001:db8:0::1", TTL: 600},
+		{RecordType: TypeCNAME, Name: "app", Content: "blue.example.net.", TTL: 300},
+		{RecordType: TypeTXT, Name: "@", Content: "192.0.2.1", TTL: 300},
+		{RecordType: TypeA, Name: "mail", Content: "192.0.2.9", TTL: 300},
+	})
+
+	mapping := map[string]string{
+		"192.0.2.1":        "198.51.100.1",
+		"2001:db8::1":      "2001:db8::2",
+		"blue.example.net": "green.example.net",
+	}
+
+	var waited []time.Duration
+
+	opts := CutoverOptions{
+		PreCutoverTTL: 60,
+		Wait: func(_ context.Context, d time.Duration) error {
+			waited = append(waited, d)
+			return nil
+		},
+	}
+
+	flipped, err := client.Cutover(context.Background(), "zzz", mapping, opts)
+	require.NoError(t, err)
+
+	assert.Len(t, flipped, 4)
+	assert.Equal(t, []time.Duration{time.Hour}, waited)
+
+	expected := []string{
+		"@ txt 192.0.2.1",
+		"mail a 192.0.2.9",
+		"@ a 198.51.100.1",
+		"www a 198.51.100.1",
+		"www aaaa 2001:db8::2",
+		"app cname green.example.net",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+
+	var ttls []int
+	for _, record := range flipped {
+		ttls = append(ttls, record.TTL)
+	}
+
+	assert.Equal(t, []int{3600, 600, 600, 300}, ttls)
+}
+
+func TestClient_Cutover_invalidTarget(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 600},
+	})
+
+	_, err := client.Cutover(context.Background(), "zzz", map[string]string{"192.0.2.1": "green.example.net"}, CutoverOptions{})
+	require.EqualError(t, err, "record www (a): the target green.example.net is not an IPv4 address")
+
+	assert.Equal(t, []string{"www a 192.0.2.1"}, api.snapshot())
+}
+
+func TestClient_Cutover_noMatch(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 600},
+	})
+
+	_, err := client.Cutover(context.Background(), "zzz", map[string]string{"192.0.2.2": "192.0.2.3"}, CutoverOptions{})
+	require.EqualError(t, err, "no record matches the cutover mapping")
+}
+
+func TestClient_Cutover_cnameConflictCheck(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeCNAME, Name: "app", Content: "blue.example.net.", TTL: 3600},
+		{RecordType: TypeCNAME, Name: "api", Content: "blue.example.net.", TTL: 30},
+	}, WithCNAMEConflictCheck())
+
+	opts := CutoverOptions{
+		PreCutoverTTL: 60,
+		Wait:          func(context.Context, time.Duration) error { return nil },
+	}
+
+	flipped, err := client.Cutover(context.Background(), "zzz", map[string]string{"blue.example.net": "green.example.net"}, opts)
+	require.NoError(t, err)
+
+	assert.Len(t, flipped, 2)
+
+	expected := []string{
+		"app cname green.example.net",
+		"api cname green.example.net",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+
+	// the TTL of api is already lower than the pre-cutover TTL: only app is recreated before the flip.
+	assert.Equal(t, 5, api.seq)
+}
@@ -0,0 +1,92 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DuplicateRecords is a group of records with the same name, type and content.
+type DuplicateRecords struct {
+	// Kept is the record kept by DedupeRecords (the oldest one).
+	Kept Record
+	// Duplicates are the other records of the group.
+	Duplicates []Record
+}
+
+// FindDuplicateRecords finds the records with the same name (matched with RelativeName), type and content.
+// The TTL is ignored.
+func (c Client) FindDuplicateRecords(ctx context.Context, zoneID string) ([]DuplicateRecords, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return findDuplicates(zone.Name, records), nil
+}
+
+// DedupeRecords deletes the duplicate records (see FindDuplicateRecords), the oldest record of each group is kept.
+// In dry-run mode, nothing is deleted.
+// It returns the deleted records (or the records to delete in dry-run mode).
+func (c Client) DedupeRecords(ctx context.Context, zoneID string, dryRun bool) ([]Record, error) {
+	groups, err := c.FindDuplicateRecords(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []Record
+
+	for _, group := range groups {
+		for _, record := range group.Duplicates {
+			if !dryRun {
+				_, err := c.DeleteRecord(ctx, zoneID, record.ID)
+				if err != nil {
+					return deleted, fmt.Errorf("delete duplicate record %s (%s) [%s]: %w", record.Name, record.RecordType, record.ID, err)
+				}
+			}
+
+			deleted = append(deleted, record)
+		}
+	}
+
+	return deleted, nil
+}
+
+func findDuplicates(zoneName string, records []Record) []DuplicateRecords {
+	groups := map[string][]Record{}
+
+	var keys []string
+
+	for _, record := range records {
+		key := strings.Join([]string{string(record.RecordType), RelativeName(record.Name, zoneName), record.Content}, "\x00")
+
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], record)
+	}
+
+	var duplicates []DuplicateRecords
+
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].CreatedAt.Before(group[j].CreatedAt)
+		})
+
+		duplicates = append(duplicates, DuplicateRecords{Kept: group[0], Duplicates: group[1:]})
+	}
+
+	return duplicates
+}
@@ -0,0 +1,50 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DedupeRecords(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeTXT, Name: "www", Content: "foo", TTL: 60, CreatedAt: now.Add(time.Hour)},
+		{RecordType: TypeTXT, Name: "www.example.com", Content: "foo", TTL: 3600, CreatedAt: now},
+		{RecordType: TypeTXT, Name: "www", Content: "bar", TTL: 60, CreatedAt: now},
+		{RecordType: TypeA, Name: "@", Content: "1.1.1.1", TTL: 60, CreatedAt: now},
+		{RecordType: TypeA, Name: "example.com", Content: "1.1.1.1", TTL: 60, CreatedAt: now},
+		{RecordType: TypeA, Name: "@", Content: "1.1.1.1", TTL: 60, CreatedAt: now},
+	})
+
+	groups, err := client.FindDuplicateRecords(context.Background(), "zzz")
+	require.NoError(t, err)
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, "id2", groups[0].Kept.ID)
+	assert.Equal(t, "id1", groups[0].Duplicates[0].ID)
+	assert.Equal(t, "id4", groups[1].Kept.ID)
+	assert.Len(t, groups[1].Duplicates, 2)
+
+	deleted, err := client.DedupeRecords(context.Background(), "zzz", true)
+	require.NoError(t, err)
+
+	assert.Len(t, deleted, 3)
+	assert.Len(t, api.snapshot(), 6)
+
+	deleted, err = client.DedupeRecords(context.Background(), "zzz", false)
+	require.NoError(t, err)
+
+	assert.Len(t, deleted, 3)
+
+	expected := []string{
+		"www.example.com txt foo",
+		"www txt bar",
+		"@ a 1.1.1.1",
+	}
+	assert.Equal(t, expected, api.snapshot())
+}
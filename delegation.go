@@ -0,0 +1,160 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// delegationTTL is the TTL of the records of a delegation.
+const delegationTTL = 3600
+
+// Glue is the addresses of a nameserver inside a delegated subdomain (e.g. `ns1.sub.example.com` for `sub.example.com`).
+// The glue records are the A and AAAA records of the nameserver in the parent zone.
+type Glue struct {
+	Nameserver string
+	Addresses  []netip.Addr
+}
+
+// DelegateSubdomain delegates a subdomain of a zone to nameservers:
+// the NS records of the subdomain are set to exactly the nameservers (see SetRecordSet),
+// and the glue records are set for the nameservers inside the subdomain.
+// It returns the resulting NS and glue records.
+func (c Client) DelegateSubdomain(ctx context.Context, parentZoneID, subdomain string, nameservers []string, glue ...Glue) ([]Record, error) {
+	zone, err := c.getZone(ctx, parentZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := delegatedName(zone, subdomain)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nameservers) == 0 {
+		return nil, errors.New("at least one nameserver is required")
+	}
+
+	values := make([]string, 0, len(nameservers))
+	for _, ns := range nameservers {
+		values = append(values, normalizeHostname(ns))
+	}
+
+	for _, g := range glue {
+		ns := normalizeHostname(g.Nameserver)
+
+		if !contains(values, ns) {
+			return nil, fmt.Errorf("the glue nameserver %s is not a nameserver of the delegation", g.Nameserver)
+		}
+
+		if !inZone(ns, fqdn(name, zone.Name)) {
+			return nil, fmt.Errorf("the glue nameserver %s is outside the subdomain %s", g.Nameserver, subdomain)
+		}
+	}
+
+	records, err := c.SetRecordSet(ctx, parentZoneID, name, TypeNS, values, delegationTTL)
+	if err != nil {
+		return nil, fmt.Errorf("set NS records: %w", err)
+	}
+
+	for _, g := range glue {
+		ns := RelativeName(g.Nameserver, zone.Name)
+
+		addresses := map[RecordType][]string{}
+		for _, addr := range g.Addresses {
+			recordType := TypeAAAA
+			if addr.Unmap().Is4() {
+				recordType = TypeA
+			}
+
+			addresses[recordType] = append(addresses[recordType], addr.Unmap().String())
+		}
+
+		for _, recordType := range []RecordType{TypeA, TypeAAAA} {
+			if len(addresses[recordType]) == 0 {
+				continue
+			}
+
+			set, errS := c.SetRecordSet(ctx, parentZoneID, ns, recordType, addresses[recordType], delegationTTL)
+			if errS != nil {
+				return nil, fmt.Errorf("set glue records %s (%s): %w", ns, recordType, errS)
+			}
+
+			records = append(records, set...)
+		}
+	}
+
+	return records, nil
+}
+
+// UndelegateSubdomain removes the delegation of a subdomain of a zone (see DelegateSubdomain):
+// the NS records of the subdomain, and the glue records of the nameservers inside the subdomain, are deleted.
+// The deletions are applied with a ChangeSet.
+// It returns the deleted records.
+func (c Client) UndelegateSubdomain(ctx context.Context, parentZoneID, subdomain string) ([]Record, error) {
+	zone, err := c.getZone(ctx, parentZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := delegatedName(zone, subdomain)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, parentZoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var toDelete []Record
+
+	glue := map[string]struct{}{}
+
+	for _, record := range records {
+		if record.RecordType != TypeNS || RelativeName(record.Name, zone.Name) != name {
+			continue
+		}
+
+		toDelete = append(toDelete, record)
+
+		ns := normalizeHostname(record.Content)
+		if inZone(ns, fqdn(name, zone.Name)) {
+			glue[RelativeName(ns, zone.Name)] = struct{}{}
+		}
+	}
+
+	for _, record := range records {
+		if record.RecordType != TypeA && record.RecordType != TypeAAAA {
+			continue
+		}
+
+		if _, ok := glue[RelativeName(record.Name, zone.Name)]; ok {
+			toDelete = append(toDelete, record)
+		}
+	}
+
+	changeSet := c.BeginChangeSet(parentZoneID)
+
+	for _, record := range toDelete {
+		changeSet.Delete(record)
+	}
+
+	_, err = changeSet.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDelete, nil
+}
+
+// delegatedName returns the name of a subdomain relative to a zone.
+func delegatedName(zone *Zone, subdomain string) (string, error) {
+	name := RelativeName(subdomain, zone.Name)
+	if name == "@" {
+		return "", fmt.Errorf("the apex of the zone %s cannot be delegated", zone.Name)
+	}
+
+	return name, nil
+}
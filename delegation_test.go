@@ -0,0 +1,105 @@
+package nodion
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DelegateSubdomain(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeNS, Name: "@", Content: "ns1.nodion.com", TTL: 3600},
+		{RecordType: TypeNS, Name: "sub", Content: "ns.old.example.net", TTL: 3600},
+	})
+
+	glue := Glue{
+		Nameserver: "ns1.sub.example.com.",
+		Addresses:  []netip.Addr{netip.MustParseAddr("192.0.2.1"), netip.MustParseAddr("2001:db8::1")},
+	}
+
+	records, err := client.DelegateSubdomain(context.Background(), "zzz", "sub.example.com",
+		[]string{"ns1.sub.example.com.", "NS2.example.net"}, glue)
+	require.NoError(t, err)
+
+	assert.Equal(t, []RecordType{TypeNS, TypeNS, TypeA, TypeAAAA}, recordTypes(records))
+
+	expected := []string{
+		"@ ns ns1.nodion.com",
+		"sub ns ns1.sub.example.com",
+		"sub ns ns2.example.net",
+		"ns1.sub a 192.0.2.1",
+		"ns1.sub aaaa 2001:db8::1",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+}
+
+func TestClient_DelegateSubdomain_errors(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		subdomain   string
+		nameservers []string
+		glue        []Glue
+		expected    string
+	}{
+		{
+			desc:        "apex",
+			subdomain:   "example.com.",
+			nameservers: []string{"ns1.example.net"},
+			expected:    "the apex of the zone example.com cannot be delegated",
+		},
+		{
+			desc:      "no nameserver",
+			subdomain: "sub",
+			expected:  "at least one nameserver is required",
+		},
+		{
+			desc:        "glue of another nameserver",
+			subdomain:   "sub",
+			nameservers: []string{"ns1.sub.example.com"},
+			glue:        []Glue{{Nameserver: "ns2.sub.example.com"}},
+			expected:    "the glue nameserver ns2.sub.example.com is not a nameserver of the delegation",
+		},
+		{
+			desc:        "glue outside the subdomain",
+			subdomain:   "sub",
+			nameservers: []string{"ns1.example.net"},
+			glue:        []Glue{{Nameserver: "ns1.example.net"}},
+			expected:    "the glue nameserver ns1.example.net is outside the subdomain sub",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, api := setupFakeAPI(t, nil)
+
+			_, err := client.DelegateSubdomain(context.Background(), "zzz", test.subdomain, test.nameservers, test.glue...)
+			require.EqualError(t, err, test.expected)
+
+			assert.Empty(t, api.snapshot())
+		})
+	}
+}
+
+func TestClient_UndelegateSubdomain(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeNS, Name: "@", Content: "ns1.nodion.com", TTL: 3600},
+		{RecordType: TypeNS, Name: "sub", Content: "ns1.sub.example.com", TTL: 3600},
+		{RecordType: TypeNS, Name: "sub", Content: "ns2.example.net", TTL: 3600},
+		{RecordType: TypeA, Name: "ns1.sub", Content: "192.0.2.1", TTL: 3600},
+		{RecordType: TypeAAAA, Name: "ns1.sub", Content: "2001:db8::1", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.2", TTL: 3600},
+	})
+
+	deleted, err := client.UndelegateSubdomain(context.Background(), "zzz", "sub")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sub", "sub", "ns1.sub", "ns1.sub"}, recordNames(deleted))
+	assert.Equal(t, []string{"@ ns ns1.nodion.com", "www a 192.0.2.2"}, api.snapshot())
+}
@@ -0,0 +1,170 @@
+package nodion
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Deprecation headers (RFC 9745 and RFC 8594).
+const (
+	headerDeprecation = "Deprecation"
+	headerSunset      = "Sunset"
+)
+
+// DeprecationNotice describes the deprecation of an endpoint announced by the API.
+type DeprecationNotice struct {
+	Method   string
+	Endpoint string
+
+	// DeprecatedAt is the date of the deprecation (zero if the API doesn't provide it).
+	DeprecatedAt time.Time
+	// Sunset is the date after which the endpoint will be unavailable (zero if the API doesn't provide it).
+	Sunset time.Time
+	// Link is the link to the documentation of the deprecation (Link header with the relation `deprecation` or `sunset`).
+	Link string
+}
+
+// DeprecationHandler is called when the API announces the deprecation of an endpoint.
+type DeprecationHandler func(ctx context.Context, notice DeprecationNotice)
+
+// WithDeprecationHandler registers a handler called when a response has a Deprecation or a Sunset header.
+// The handler is called once per endpoint (method and path).
+// Without a handler, the deprecations are logged as warnings (see WithLogger).
+func WithDeprecationHandler(handler DeprecationHandler) Option {
+	return func(c *Client) error {
+		c.deprecations.handler = handler
+		return nil
+	}
+}
+
+type deprecationState struct {
+	handler DeprecationHandler
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+func newDeprecationState() *deprecationState {
+	return &deprecationState{seen: map[string]struct{}{}}
+}
+
+// checkDeprecation reports the deprecation announced by the headers of a response.
+func (c Client) checkDeprecation(req *http.Request, header http.Header) {
+	notice, ok := parseDeprecation(header)
+	if !ok || (c.deprecations.handler == nil && c.logger == nil) {
+		return
+	}
+
+	notice.Method = req.Method
+	notice.Endpoint = req.URL.Path
+
+	if !c.deprecations.first(notice.Method + " " + notice.Endpoint) {
+		return
+	}
+
+	if c.deprecations.handler != nil {
+		c.deprecations.handler(req.Context(), notice)
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", notice.Method),
+		slog.String("endpoint", notice.Endpoint),
+	}
+
+	if !notice.DeprecatedAt.IsZero() {
+		attrs = append(attrs, slog.Time("deprecated_at", notice.DeprecatedAt))
+	}
+
+	if !notice.Sunset.IsZero() {
+		attrs = append(attrs, slog.Time("sunset", notice.Sunset))
+	}
+
+	if notice.Link != "" {
+		attrs = append(attrs, slog.String("link", notice.Link))
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelWarn, "deprecated API endpoint", attrs...)
+}
+
+// first reports whether a deprecation is reported for the first time.
+func (d *deprecationState) first(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+
+	d.seen[key] = struct{}{}
+
+	return true
+}
+
+// parseDeprecation parses the deprecation headers.
+// The Deprecation header is either a structured date (`@1688169599`, RFC 9745), an HTTP date, or `true` (drafts).
+// The Sunset header is an HTTP date.
+func parseDeprecation(header http.Header) (DeprecationNotice, bool) {
+	deprecation := strings.TrimSpace(header.Get(headerDeprecation))
+	sunset := strings.TrimSpace(header.Get(headerSunset))
+
+	if deprecation == "" && sunset == "" {
+		return DeprecationNotice{}, false
+	}
+
+	var notice DeprecationNotice
+
+	switch {
+	case strings.HasPrefix(deprecation, "@"):
+		if seconds, err := strconv.ParseInt(deprecation[1:], 10, 64); err == nil {
+			notice.DeprecatedAt = time.Unix(seconds, 0).UTC()
+		}
+	case deprecation != "":
+		if date, err := http.ParseTime(deprecation); err == nil {
+			notice.DeprecatedAt = date
+		}
+	}
+
+	if sunset != "" {
+		if date, err := http.ParseTime(sunset); err == nil {
+			notice.Sunset = date
+		}
+	}
+
+	notice.Link = deprecationLink(header.Values("Link"))
+
+	return notice, true
+}
+
+// deprecationLink returns the target of the first Link with the relation `deprecation` or `sunset`.
+func deprecationLink(values []string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			for _, param := range parts[1:] {
+				name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(name, "rel") {
+					continue
+				}
+
+				rel := strings.ToLower(strings.Trim(value, `"`))
+				if rel == "deprecation" || rel == "sunset" {
+					return target[1 : len(target)-1]
+				}
+			}
+		}
+	}
+
+	return ""
+}
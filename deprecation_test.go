@@ -0,0 +1,74 @@
+package nodion
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDeprecationHandler(t *testing.T) {
+	var notices []DeprecationNotice
+
+	client, mux := setupTestMux(t, WithDeprecationHandler(func(_ context.Context, notice DeprecationNotice) {
+		notices = append(notices, notice)
+	}))
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Deprecation", "@1688169599")
+		rw.Header().Set("Sunset", "Wed, 11 Nov 2026 23:59:59 GMT")
+		rw.Header().Add("Link", `<https://example.com/changelog>; rel="alternate", <https://example.com/deprecation>; rel="deprecation"`)
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetZones(context.Background(), nil)
+		require.NoError(t, err)
+	}
+
+	expected := []DeprecationNotice{{
+		Method:       http.MethodGet,
+		Endpoint:     "/dns_zones",
+		DeprecatedAt: time.Date(2023, 6, 30, 23, 59, 59, 0, time.UTC),
+		Sunset:       time.Date(2026, 11, 11, 23, 59, 59, 0, time.UTC),
+		Link:         "https://example.com/deprecation",
+	}}
+
+	assert.Equal(t, expected, notices)
+}
+
+func TestClient_checkDeprecation_logger(t *testing.T) {
+	var buf bytes.Buffer
+
+	client, mux := setupTestMux(t, WithLogger(slog.NewTextHandler(&buf, nil)))
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Deprecation", "true")
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `level=WARN msg="deprecated API endpoint" method=GET endpoint=/dns_zones`)
+}
+
+func Test_parseDeprecation(t *testing.T) {
+	_, ok := parseDeprecation(http.Header{})
+	assert.False(t, ok)
+
+	notice, ok := parseDeprecation(http.Header{"Deprecation": []string{"Sun, 11 Nov 2018 23:59:59 GMT"}})
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2018, 11, 11, 23, 59, 59, 0, time.UTC), notice.DeprecatedAt)
+
+	notice, ok = parseDeprecation(http.Header{"Sunset": []string{"invalid"}})
+	require.True(t, ok)
+	assert.Zero(t, notice.Sunset)
+}
@@ -0,0 +1,197 @@
+package nodion
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SchemaDrift describes the fields of a response unknown to the Go types of the client.
+type SchemaDrift struct {
+	Method   string
+	Endpoint string
+
+	// Fields are the paths of the unknown fields (e.g. `dns_zones[].records[].priority`).
+	Fields []string
+}
+
+// SchemaDriftHandler is called when a response contains fields unknown to the Go types.
+type SchemaDriftHandler func(ctx context.Context, drift SchemaDrift)
+
+// WithSchemaDriftHandler enables the detection of the fields present in the responses but missing from the Go types:
+// the responses are decoded a second time into generic values, and compared to the types.
+// Each unknown field is reported once.
+// It helps to notice the evolutions of the API, and should not be used in hot paths.
+func WithSchemaDriftHandler(handler SchemaDriftHandler) Option {
+	return func(c *Client) error {
+		c.drift = &driftDetector{handler: handler, seen: map[string]struct{}{}}
+		return nil
+	}
+}
+
+type driftDetector struct {
+	handler SchemaDriftHandler
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// detect reports the unknown fields of a response decoded into result.
+func (d *driftDetector) detect(req *http.Request, raw []byte, result any) {
+	d.detectAt(req, raw, result, "")
+}
+
+// detectAt reports the unknown fields of a value at a path of the response (e.g. an item of a streamed list).
+func (d *driftDetector) detectAt(req *http.Request, raw []byte, result any, path string) {
+	if d == nil || result == nil {
+		return
+	}
+
+	var generic any
+
+	err := json.Unmarshal(raw, &generic)
+	if err != nil {
+		return
+	}
+
+	var unknown []string
+
+	collectUnknownFields(generic, reflect.TypeOf(result), path, &unknown)
+
+	fields := d.unseen(unknown)
+	if len(fields) == 0 {
+		return
+	}
+
+	d.handler(req.Context(), SchemaDrift{Method: req.Method, Endpoint: req.URL.Path, Fields: fields})
+}
+
+// unseen returns the fields not already reported.
+func (d *driftDetector) unseen(fields []string) []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var result []string
+
+	for _, field := range fields {
+		if _, ok := d.seen[field]; ok {
+			continue
+		}
+
+		d.seen[field] = struct{}{}
+		result = append(result, field)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// collectUnknownFields compares a generic JSON value to a Go type, and collects the paths of the unknown fields.
+func collectUnknownFields(value any, t reflect.Type, path string, unknown *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// the types with a custom decoding are opaque.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, item := range object {
+			field, ok := jsonField(t, key)
+			if !ok {
+				*unknown = append(*unknown, joinPath(path, key))
+				continue
+			}
+
+			collectUnknownFields(item, field.Type, joinPath(path, key), unknown)
+		}
+
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+
+		for _, item := range items {
+			collectUnknownFields(item, t.Elem(), path+"[]", unknown)
+		}
+
+	case reflect.Map:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for _, item := range object {
+			collectUnknownFields(item, t.Elem(), path+"{}", unknown)
+		}
+	}
+}
+
+// jsonField finds the field of a struct decoded from a JSON key (case-insensitive, as encoding/json).
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				if f, ok := jsonField(embedded, key); ok {
+					return f, true
+				}
+			}
+
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
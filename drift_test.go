@@ -0,0 +1,81 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSchemaDriftHandler(t *testing.T) {
+	var drifts []SchemaDrift
+
+	client, mux := setupTestMux(t, WithSchemaDriftHandler(func(_ context.Context, drift SchemaDrift) {
+		drifts = append(drifts, drift)
+	}))
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{
+  "dns_zones": [
+    {
+      "id": "zzz",
+      "name": "example.com",
+      "dnssec": true,
+      "records": [
+        {"id": "id1", "record_type": "mx", "name": "@", "content": "mail.example.com", "priority": 10}
+      ]
+    }
+  ],
+  "meta": {"total": 1}
+}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		zones, err := client.GetZones(context.Background(), nil)
+		require.NoError(t, err)
+		require.Len(t, zones, 1)
+	}
+
+	expected := []SchemaDrift{{
+		Method:   http.MethodGet,
+		Endpoint: "/dns_zones",
+		Fields:   []string{"dns_zones[].dnssec", "dns_zones[].records[].priority", "meta"},
+	}}
+
+	assert.Equal(t, expected, drifts)
+}
+
+func TestWithSchemaDriftHandler_fixtures(t *testing.T) {
+	var drifts []SchemaDrift
+
+	client, mux := setupTestMux(t, WithSchemaDriftHandler(func(_ context.Context, drift SchemaDrift) {
+		drifts = append(drifts, drift)
+	}))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	mux.HandleFixture(http.MethodGet, "/dns_zones/xxx/records", http.StatusOK, "get-dns-zones-records.json")
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.GetRecords(context.Background(), "xxx", nil)
+	require.NoError(t, err)
+
+	// the MX/SRV fields of the API are not modeled by Record.
+	expected := []SchemaDrift{
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/dns_zones",
+			Fields:   []string{"dns_zones[].records[].port", "dns_zones[].records[].prio", "dns_zones[].records[].weight"},
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/dns_zones/xxx/records",
+			Fields:   []string{"records[].port", "records[].prio", "records[].weight"},
+		},
+	}
+
+	assert.Equal(t, expected, drifts)
+}
@@ -0,0 +1,68 @@
+package nodion
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// RequestEditorFn edits a request before it's sent.
+type RequestEditorFn func(req *http.Request) error
+
+type requestEditor struct {
+	method  string
+	pattern string
+	fn      RequestEditorFn
+}
+
+// WithRequestEditor registers a function to edit the requests matching a method and a path pattern
+// (ex: add a header only on `dns_zones`).
+// The pattern is matched (path.Match) against the path relative to the base URL (ex: `dns_zones`, `dns_zones/*/records`).
+// An empty method or an empty pattern matches all the requests.
+func WithRequestEditor(method, pattern string, fn RequestEditorFn) Option {
+	return func(c *Client) error {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid request editor pattern %q: %w", pattern, err)
+		}
+
+		c.requestEditors = append(c.requestEditors, requestEditor{method: method, pattern: pattern, fn: fn})
+
+		return nil
+	}
+}
+
+func (c Client) editRequest(req *http.Request) error {
+	if len(c.requestEditors) == 0 {
+		return nil
+	}
+
+	rel := relativePath(c.baseURL, req.URL)
+
+	for _, editor := range c.requestEditors {
+		if editor.method != "" && !strings.EqualFold(editor.method, req.Method) {
+			continue
+		}
+
+		if editor.pattern != "" {
+			match, _ := path.Match(editor.pattern, rel)
+			if !match {
+				continue
+			}
+		}
+
+		err := editor.fn(req)
+		if err != nil {
+			return fmt.Errorf("edit request: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// relativePath returns the path of an endpoint relative to the base URL.
+func relativePath(baseURL, endpoint *url.URL) string {
+	return strings.Trim(strings.TrimPrefix(endpoint.Path, baseURL.Path), "/")
+}
@@ -0,0 +1,42 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestEditor(t *testing.T) {
+	editor := func(req *http.Request) error {
+		req.Header.Set("X-Beta", "true")
+		return nil
+	}
+
+	client, mux := setupTestMux(t, WithRequestEditor(http.MethodGet, "dns_zones", editor))
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "true", req.Header.Get("X-Beta"))
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	mux.HandleFunc("/dns_zones/xxx/records", func(rw http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Get("X-Beta"))
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")(rw, req)
+	})
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.GetRecords(context.Background(), "xxx", nil)
+	require.NoError(t, err)
+}
+
+func TestWithRequestEditor_invalidPattern(t *testing.T) {
+	_, err := NewClient("secret", WithRequestEditor("", "[", func(*http.Request) error { return nil }))
+	require.Error(t, err)
+}
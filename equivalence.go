@@ -0,0 +1,85 @@
+package nodion
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// EquivalenceOptions are the options of Record.EquivalentTo.
+type EquivalenceOptions struct {
+	// ZoneName is the name of the zone of the records:
+	// the relative and the fully qualified names are equivalent (`www` and `www.example.com.`).
+	ZoneName string
+
+	// TTLTolerance is the maximum difference between the TTLs of equivalent records.
+	TTLTolerance int
+
+	// IgnoreTTL ignores the TTLs.
+	IgnoreTTL bool
+}
+
+// EquivalentTo reports whether two records describe the same DNS data:
+// the IDs and the timestamps are ignored,
+// the names and the types are case-insensitive, the trailing dots are ignored,
+// the IP addresses are compared in their canonical form,
+// the hostnames in the contents are case-insensitive,
+// and the TXT contents are compared unquoted.
+func (r Record) EquivalentTo(other Record, opts EquivalenceOptions) bool {
+	if RelativeName(r.Name, opts.ZoneName) != RelativeName(other.Name, opts.ZoneName) {
+		return false
+	}
+
+	recordType := RecordType(strings.ToLower(string(r.RecordType)))
+	if recordType != RecordType(strings.ToLower(string(other.RecordType))) {
+		return false
+	}
+
+	if !opts.IgnoreTTL && abs(r.TTL-other.TTL) > opts.TTLTolerance {
+		return false
+	}
+
+	return normalizeContent(recordType, r.Content) == normalizeContent(recordType, other.Content)
+}
+
+// normalizeContent returns the canonical form of a record content.
+func normalizeContent(recordType RecordType, content string) string {
+	switch recordType {
+	case TypeA, TypeAAAA:
+		addr, err := netip.ParseAddr(strings.TrimSpace(content))
+		if err != nil {
+			return content
+		}
+
+		return addr.String()
+
+	case TypeCNAME, TypeALIAS, TypeNS, TypePTR:
+		return normalizeHostname(content)
+
+	case TypeMX, TypeSRV:
+		// the target is the last field: "10 mail.example.com", "10 5 5060 sip.example.com".
+		fields := strings.Fields(content)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = normalizeHostname(fields[len(fields)-1])
+		}
+
+		return strings.Join(fields, " ")
+
+	case TypeTXT:
+		return JoinTXT(content)
+
+	default:
+		return content
+	}
+}
+
+func normalizeHostname(name string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
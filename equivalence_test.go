@@ -0,0 +1,99 @@
+package nodion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecord_EquivalentTo(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		a, b     Record
+		opts     EquivalenceOptions
+		expected bool
+	}{
+		{
+			desc:     "identical",
+			a:        Record{ID: "id1", RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			b:        Record{ID: "id2", RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			expected: true,
+		},
+		{
+			desc:     "fully qualified name",
+			a:        Record{RecordType: TypeA, Name: "WWW.example.com.", Content: "192.0.2.1"},
+			b:        Record{RecordType: "A", Name: "www", Content: "192.0.2.1"},
+			opts:     EquivalenceOptions{ZoneName: "example.com"},
+			expected: true,
+		},
+		{
+			desc:     "apex",
+			a:        Record{RecordType: TypeA, Name: "", Content: "192.0.2.1"},
+			b:        Record{RecordType: TypeA, Name: "@", Content: "192.0.2.1"},
+			expected: true,
+		},
+		{
+			desc:     "IPv6 canonical form",
+			a:        Record{RecordType: TypeAAAA, Name: "www", Content: "2001:DB8:0:0::1"},
+			b:        Record{RecordType: TypeAAAA, Name: "www", Content: "2001:db8::1"},
+			expected: true,
+		},
+		{
+			desc:     "hostname",
+			a:        Record{RecordType: TypeCNAME, Name: "www", Content: "Example.org."},
+			b:        Record{RecordType: TypeCNAME, Name: "www", Content: "example.org"},
+			expected: true,
+		},
+		{
+			desc:     "MX",
+			a:        Record{RecordType: TypeMX, Name: "@", Content: "10  MAIL.example.com."},
+			b:        Record{RecordType: TypeMX, Name: "@", Content: "10 mail.example.com"},
+			expected: true,
+		},
+		{
+			desc:     "TXT quoted",
+			a:        Record{RecordType: TypeTXT, Name: "@", Content: `"v=spf1 -all"`},
+			b:        Record{RecordType: TypeTXT, Name: "@", Content: "v=spf1 -all"},
+			expected: true,
+		},
+		{
+			desc: "TXT case-sensitive",
+			a:    Record{RecordType: TypeTXT, Name: "@", Content: "abc"},
+			b:    Record{RecordType: TypeTXT, Name: "@", Content: "ABC"},
+		},
+		{
+			desc: "different TTL",
+			a:    Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			b:    Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3000},
+		},
+		{
+			desc:     "TTL tolerance",
+			a:        Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			b:        Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3000},
+			opts:     EquivalenceOptions{TTLTolerance: 600},
+			expected: true,
+		},
+		{
+			desc:     "ignore TTL",
+			a:        Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			b:        Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 60},
+			opts:     EquivalenceOptions{IgnoreTTL: true},
+			expected: true,
+		},
+		{
+			desc: "different type",
+			a:    Record{RecordType: TypeCNAME, Name: "www", Content: "example.org"},
+			b:    Record{RecordType: TypeALIAS, Name: "www", Content: "example.org"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.a.EquivalentTo(test.b, test.opts))
+			assert.Equal(t, test.expected, test.b.EquivalentTo(test.a, test.opts))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package nodion
+
+import (
+	"context"
+	"strings"
+)
+
+// ZoneExists reports whether a zone exists.
+// The zones are filtered by the API: only the matching zone is fetched.
+func (c Client) ZoneExists(ctx context.Context, name string) (bool, error) {
+	name = strings.TrimSuffix(name, ".")
+
+	zones, err := c.GetZones(ctx, &ZonesFilter{Name: name})
+	if err != nil {
+		return false, err
+	}
+
+	for _, zone := range zones {
+		if strings.EqualFold(zone.Name, name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// RecordExists reports whether a zone contains at least one record with the name and the type.
+// The records are filtered by the API: only the matching records are fetched.
+func (c Client) RecordExists(ctx context.Context, zoneID, name string, recordType RecordType) (bool, error) {
+	records, err := c.GetRecords(ctx, zoneID, &RecordsFilter{Name: name, RecordType: recordType})
+	if err != nil {
+		return false, err
+	}
+
+	for _, record := range records {
+		if record.Name == name && record.RecordType == recordType.normalized() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,35 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ZoneExists(t *testing.T) {
+	client, _ := setupFakeAPI(t, nil)
+
+	exists, err := client.ZoneExists(context.Background(), "example.com.")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = client.ZoneExists(context.Background(), "example.org")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestClient_RecordExists(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	})
+
+	exists, err := client.RecordExists(context.Background(), "zzz", "www", TypeA)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = client.RecordExists(context.Background(), "zzz", "www", TypeAAAA)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
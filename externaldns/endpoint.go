@@ -0,0 +1,113 @@
+// Package externaldns converts the records of Nodion to and from the Endpoint model of external-dns
+// (https://github.com/kubernetes-sigs/external-dns), without depending on external-dns.
+package externaldns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nrdcg/nodion"
+)
+
+// DefaultTTL is the TTL of the records created from an Endpoint without TTL.
+const DefaultTTL = 3600
+
+// Endpoint is a DNS name with its targets, with the same fields as the external-dns Endpoint.
+type Endpoint struct {
+	// DNSName is the fully qualified name (without trailing dot).
+	DNSName string
+	// Targets are the contents of the records.
+	Targets []string
+	// RecordType is the record type in uppercase (A, AAAA, CNAME, ...).
+	RecordType string
+	// RecordTTL is the TTL in seconds (0 means not configured).
+	RecordTTL int64
+}
+
+// FromRecords converts the records of a zone to endpoints:
+// the records with the same name, type and TTL are flattened into a single endpoint with several targets.
+// The endpoints are sorted by name and type.
+func FromRecords(zoneName string, records []nodion.Record) []*Endpoint {
+	endpoints := map[string]*Endpoint{}
+
+	for _, record := range records {
+		dnsName := FQDN(record.Name, zoneName)
+		recordType := strings.ToUpper(string(record.RecordType))
+
+		key := fmt.Sprintf("%s %s %d", dnsName, recordType, record.TTL)
+
+		ep, ok := endpoints[key]
+		if !ok {
+			ep = &Endpoint{DNSName: dnsName, RecordType: recordType, RecordTTL: int64(record.TTL)}
+			endpoints[key] = ep
+		}
+
+		ep.Targets = append(ep.Targets, record.Content)
+	}
+
+	result := make([]*Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		sort.Strings(ep.Targets)
+		result = append(result, ep)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DNSName != result[j].DNSName {
+			return result[i].DNSName < result[j].DNSName
+		}
+
+		if result[i].RecordType != result[j].RecordType {
+			return result[i].RecordType < result[j].RecordType
+		}
+
+		return result[i].RecordTTL < result[j].RecordTTL
+	})
+
+	return result
+}
+
+// ToRecords converts an endpoint to the records of a zone (one record per target).
+func ToRecords(zoneName string, ep *Endpoint) ([]nodion.Record, error) {
+	dnsName := strings.ToLower(strings.TrimSuffix(ep.DNSName, "."))
+	zone := strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	if dnsName != zone && !strings.HasSuffix(dnsName, "."+zone) {
+		return nil, fmt.Errorf("endpoint %s is not in the zone %s", ep.DNSName, zoneName)
+	}
+
+	recordType, err := nodion.ParseRecordType(ep.RecordType)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint %s: %w", ep.DNSName, err)
+	}
+
+	ttl := int(ep.RecordTTL)
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+
+	records := make([]nodion.Record, 0, len(ep.Targets))
+
+	for _, target := range ep.Targets {
+		records = append(records, nodion.Record{
+			RecordType: recordType,
+			Name:       nodion.RelativeName(dnsName, zone),
+			Content:    target,
+			TTL:        ttl,
+		})
+	}
+
+	return records, nil
+}
+
+// FQDN returns the fully qualified name (without trailing dot) of a record name relative to a zone.
+func FQDN(name, zoneName string) string {
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	relative := nodion.RelativeName(name, zoneName)
+	if relative == "@" {
+		return zoneName
+	}
+
+	return relative + "." + zoneName
+}
@@ -0,0 +1,63 @@
+package externaldns
+
+import (
+	"testing"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRecords(t *testing.T) {
+	records := []nodion.Record{
+		{RecordType: nodion.TypeA, Name: "www", Content: "2.2.2.2", TTL: 300},
+		{RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 300},
+		{RecordType: nodion.TypeA, Name: "@", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: nodion.TypeTXT, Name: "www.example.com", Content: "foo", TTL: 300},
+	}
+
+	expected := []*Endpoint{
+		{DNSName: "example.com", Targets: []string{"1.1.1.1"}, RecordType: "A", RecordTTL: 3600},
+		{DNSName: "www.example.com", Targets: []string{"1.1.1.1", "2.2.2.2"}, RecordType: "A", RecordTTL: 300},
+		{DNSName: "www.example.com", Targets: []string{"foo"}, RecordType: "TXT", RecordTTL: 300},
+	}
+
+	assert.Equal(t, expected, FromRecords("example.com.", records))
+}
+
+func TestToRecords(t *testing.T) {
+	ep := &Endpoint{DNSName: "www.example.com.", Targets: []string{"1.1.1.1", "2.2.2.2"}, RecordType: "A"}
+
+	records, err := ToRecords("example.com", ep)
+	require.NoError(t, err)
+
+	expected := []nodion.Record{
+		{RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: DefaultTTL},
+		{RecordType: nodion.TypeA, Name: "www", Content: "2.2.2.2", TTL: DefaultTTL},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestToRecords_apex(t *testing.T) {
+	ep := &Endpoint{DNSName: "example.com", Targets: []string{"10 mail.example.com"}, RecordType: "MX", RecordTTL: 60}
+
+	records, err := ToRecords("example.com", ep)
+	require.NoError(t, err)
+
+	assert.Equal(t, []nodion.Record{{RecordType: nodion.TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 60}}, records)
+}
+
+func TestToRecords_errors(t *testing.T) {
+	_, err := ToRecords("example.com", &Endpoint{DNSName: "www.example.org", Targets: []string{"1.1.1.1"}, RecordType: "A"})
+	require.EqualError(t, err, "endpoint www.example.org is not in the zone example.com")
+
+	_, err = ToRecords("example.com", &Endpoint{DNSName: "www.example.com", Targets: []string{"0 issue letsencrypt.org"}, RecordType: "CAA"})
+	require.EqualError(t, err, "endpoint www.example.com: unsupported record type: CAA")
+}
+
+func TestFQDN(t *testing.T) {
+	assert.Equal(t, "example.com", FQDN("@", "example.com."))
+	assert.Equal(t, "www.example.com", FQDN("www", "example.com"))
+	assert.Equal(t, "www.example.com", FQDN("www.example.com.", "example.com"))
+}
@@ -0,0 +1,128 @@
+package nodion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeAPI is a minimal in-memory implementation of the API with a single zone `zzz` (example.com).
+type fakeAPI struct {
+	mu      sync.Mutex
+	seq     int
+	records map[string]Record
+}
+
+func setupFakeAPI(t *testing.T, records []Record, opts ...Option) (*Client, *fakeAPI) {
+	t.Helper()
+
+	api := &fakeAPI{records: map[string]Record{}}
+
+	for _, record := range records {
+		if record.ID == "" {
+			api.seq++
+			record.ID = fmt.Sprintf("id%d", api.seq)
+		}
+
+		api.records[record.ID] = record
+	}
+
+	client, mux := setupTestMux(t, opts...)
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(rw).Encode(ZonesResponse{Zones: []Zone{{ID: "zzz", Name: "example.com"}}})
+	})
+	mux.Handle("/dns_zones/zzz/records", api)
+	mux.Handle("/dns_zones/zzz/records/", api)
+
+	return client, api
+}
+
+func (f *fakeAPI) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/dns_zones/zzz/records"), "/")
+
+	switch req.Method {
+	case http.MethodGet:
+		query := req.URL.Query()
+
+		records := []Record{}
+
+		for _, record := range f.sorted() {
+			if query.Get("name") != "" && query.Get("name") != record.Name ||
+				query.Get("record_type") != "" && query.Get("record_type") != string(record.RecordType) ||
+				query.Get("content") != "" && query.Get("content") != record.Content {
+				continue
+			}
+
+			records = append(records, record)
+		}
+
+		_ = json.NewEncoder(rw).Encode(RecordsResponse{Records: records})
+
+	case http.MethodPost:
+		var record Record
+
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, `{"errors": ["invalid body"]}`, http.StatusBadRequest)
+			return
+		}
+
+		f.seq++
+		record.ID = fmt.Sprintf("id%d", f.seq)
+		f.records[record.ID] = record
+
+		_ = json.NewEncoder(rw).Encode(RecordResponse{Record: record})
+
+	case http.MethodDelete:
+		if _, ok := f.records[id]; !ok {
+			http.Error(rw, `{"status": 404, "error": "Not Found"}`, http.StatusNotFound)
+			return
+		}
+
+		delete(f.records, id)
+
+		_ = json.NewEncoder(rw).Encode(DeleteResponse{Deleted: true})
+
+	default:
+		http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+	}
+}
+
+// sorted returns the records sorted by ID sequence.
+func (f *fakeAPI) sorted() []Record {
+	var records []Record
+	for _, record := range f.records {
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if len(records[i].ID) != len(records[j].ID) {
+			return len(records[i].ID) < len(records[j].ID)
+		}
+
+		return records[i].ID < records[j].ID
+	})
+
+	return records
+}
+
+// snapshot returns the records as `name type content` strings.
+func (f *fakeAPI) snapshot() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var lines []string
+	for _, record := range f.sorted() {
+		lines = append(lines, fmt.Sprintf("%s %s %s", record.Name, record.RecordType, record.Content))
+	}
+
+	return lines
+}
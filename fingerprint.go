@@ -0,0 +1,28 @@
+package nodion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// Fingerprint returns a stable hash (hex-encoded SHA-256) of the semantic fields of the record:
+// the type, the name, the content, and the TTL.
+// They are normalized as by EquivalentTo (with no zone name and no TTL tolerance):
+// the equivalent records have the same fingerprint.
+// The IDs, the zone IDs, the timestamps, and the tags are ignored.
+func (r Record) Fingerprint() string {
+	recordType := RecordType(strings.ToLower(string(r.RecordType)))
+
+	fields := []string{
+		string(recordType),
+		RelativeName(r.Name, ""),
+		normalizeContent(recordType, r.Content),
+		strconv.Itoa(r.TTL),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x00")))
+
+	return hex.EncodeToString(sum[:])
+}
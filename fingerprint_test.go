@@ -0,0 +1,25 @@
+package nodion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecord_Fingerprint(t *testing.T) {
+	record := Record{ID: "id1", RecordType: TypeCNAME, Name: "www", Content: "example.org", TTL: 3600}
+
+	assert.Len(t, record.Fingerprint(), 64)
+
+	equivalent := Record{ID: "id2", ZoneID: "zzz", RecordType: "CNAME", Name: "WWW.", Content: "Example.org.", TTL: 3600}
+	assert.Equal(t, record.Fingerprint(), equivalent.Fingerprint())
+
+	other := record
+	other.TTL = 60
+	assert.NotEqual(t, record.Fingerprint(), other.Fingerprint())
+
+	other = record
+	other.Name = "ww"
+	other.Content = "wexample.org"
+	assert.NotEqual(t, record.Fingerprint(), other.Fingerprint())
+}
@@ -0,0 +1,136 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ErrZoneFrozen is returned by the mutations of a frozen zone (see FreezeZone and WithFreezeMarker).
+var ErrZoneFrozen = errors.New("zone frozen")
+
+// FreezeMarkerName is the name of the TXT record marking a zone as frozen (see WithFreezeMarker).
+const FreezeMarkerName = "_nodion-freeze"
+
+type freezeBypassKey struct{}
+
+// WithFreezeMarker makes the client respect the freeze marker of the zones (see SetFreezeMarker):
+// the mutations of a zone with a marker return ErrZoneFrozen,
+// so the freeze is shared by all the processes using the option.
+// The marker is checked with an additional call to the API before each mutation (see WithCache).
+func WithFreezeMarker() Option {
+	return func(c *Client) error {
+		c.freezeMarker = true
+		return nil
+	}
+}
+
+// zoneFreezes is the set of the zones frozen by a client.
+// A nil zoneFreezes freezes nothing.
+type zoneFreezes struct {
+	mu    sync.RWMutex
+	zones map[string]struct{}
+}
+
+func newZoneFreezes() *zoneFreezes {
+	return &zoneFreezes{zones: make(map[string]struct{})}
+}
+
+func (f *zoneFreezes) frozen(zoneID string) bool {
+	if f == nil {
+		return false
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	_, ok := f.zones[zoneID]
+
+	return ok
+}
+
+// FreezeZone freezes a zone: the mutations of the zone made by the client (and its copies) return ErrZoneFrozen,
+// until UnfreezeZone.
+// The freeze is local to the process: SetFreezeMarker shares it with the other processes.
+func (c Client) FreezeZone(zoneID string) {
+	c.freezes.mu.Lock()
+	defer c.freezes.mu.Unlock()
+
+	c.freezes.zones[zoneID] = struct{}{}
+}
+
+// UnfreezeZone unfreezes a zone frozen by FreezeZone.
+func (c Client) UnfreezeZone(zoneID string) {
+	c.freezes.mu.Lock()
+	defer c.freezes.mu.Unlock()
+
+	delete(c.freezes.zones, zoneID)
+}
+
+// SetFreezeMarker creates the freeze marker of a zone (a TXT record named FreezeMarkerName, with the reason as content):
+// the mutations of the zone made by the clients using WithFreezeMarker return ErrZoneFrozen, until RemoveFreezeMarker.
+func (c Client) SetFreezeMarker(ctx context.Context, zoneID, reason string) error {
+	if reason == "" {
+		reason = "frozen"
+	}
+
+	_, err := c.CreateRecord(context.WithValue(ctx, freezeBypassKey{}, true), zoneID, Record{
+		RecordType: TypeTXT,
+		Name:       FreezeMarkerName,
+		Content:    reason,
+		TTL:        60,
+	})
+	if err != nil {
+		return fmt.Errorf("create freeze marker: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFreezeMarker removes the freeze marker of a zone (see SetFreezeMarker).
+func (c Client) RemoveFreezeMarker(ctx context.Context, zoneID string) error {
+	_, err := c.DeleteRecordByMatch(context.WithValue(ctx, freezeBypassKey{}, true), zoneID,
+		RecordMatch{Name: FreezeMarkerName, RecordType: TypeTXT})
+	if err != nil {
+		return fmt.Errorf("remove freeze marker: %w", err)
+	}
+
+	return nil
+}
+
+// checkFreeze refuses the mutating requests related to a frozen zone.
+func (c Client) checkFreeze(req *http.Request) error {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return nil
+	}
+
+	if bypass, _ := req.Context().Value(freezeBypassKey{}).(bool); bypass {
+		return nil
+	}
+
+	zoneID := zoneIDFromPath(c.baseURL, req.URL)
+	if zoneID == "" {
+		return nil
+	}
+
+	if c.freezes.frozen(zoneID) {
+		return fmt.Errorf("%w: %s", ErrZoneFrozen, zoneID)
+	}
+
+	if !c.freezeMarker {
+		return nil
+	}
+
+	markers, err := c.GetRecords(req.Context(), zoneID, &RecordsFilter{Name: FreezeMarkerName, RecordType: TypeTXT})
+	if err != nil {
+		return fmt.Errorf("check freeze marker: %w", err)
+	}
+
+	if len(markers) > 0 {
+		return fmt.Errorf("%w: %s (%s)", ErrZoneFrozen, zoneID, JoinTXT(markers[0].Content))
+	}
+
+	return nil
+}
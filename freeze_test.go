@@ -0,0 +1,66 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_FreezeZone(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	})
+
+	ctx := context.Background()
+
+	client.FreezeZone("zzz")
+
+	_, err := client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "api", Content: "192.0.2.2", TTL: 3600})
+	require.ErrorIs(t, err, ErrZoneFrozen)
+
+	_, err = client.DeleteRecord(ctx, "zzz", "id1")
+	require.ErrorIs(t, err, ErrZoneFrozen)
+
+	_, err = client.DeleteZone(ctx, "zzz")
+	require.ErrorIs(t, err, ErrZoneFrozen)
+
+	// the reads are allowed.
+	_, err = client.GetRecords(ctx, "zzz", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www a 192.0.2.1"}, api.snapshot())
+
+	client.UnfreezeZone("zzz")
+
+	_, err = client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "api", Content: "192.0.2.2", TTL: 3600})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www a 192.0.2.1", "api a 192.0.2.2"}, api.snapshot())
+}
+
+func TestClient_SetFreezeMarker(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	}, WithFreezeMarker())
+
+	ctx := context.Background()
+
+	err := client.SetFreezeMarker(ctx, "zzz", "migration in progress")
+	require.NoError(t, err)
+
+	_, err = client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "api", Content: "192.0.2.2", TTL: 3600})
+	require.EqualError(t, err, "zone frozen: zzz (migration in progress)")
+
+	_, err = client.DeleteRecord(ctx, "zzz", "id1")
+	require.ErrorIs(t, err, ErrZoneFrozen)
+
+	err = client.RemoveFreezeMarker(ctx, "zzz")
+	require.NoError(t, err)
+
+	_, err = client.DeleteRecord(ctx, "zzz", "id1")
+	require.NoError(t, err)
+
+	assert.Empty(t, api.snapshot())
+}
@@ -1,14 +1,15 @@
 module github.com/nrdcg/nodion
 
-go 1.19
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/sync v0.6.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
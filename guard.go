@@ -0,0 +1,72 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors related to the protection of the zone deletion.
+var (
+	ErrDeletionNotConfirmed = errors.New("zone deletion not confirmed")
+	ErrZoneDeletionDisabled = errors.New("zone deletion is disabled")
+)
+
+type deletionGuardMode int
+
+const (
+	deletionGuardConfirm deletionGuardMode = iota + 1
+	deletionGuardDisabled
+)
+
+// WithDeletionGuard requires an explicit confirmation to delete a zone:
+// DeleteZone is refused, and DeleteZoneConfirmed must be used.
+func WithDeletionGuard() Option {
+	return func(c *Client) error {
+		c.deletionGuard = deletionGuardConfirm
+		return nil
+	}
+}
+
+// WithZoneDeletionDisabled refuses all the zone deletions.
+func WithZoneDeletionDisabled() Option {
+	return func(c *Client) error {
+		c.deletionGuard = deletionGuardDisabled
+		return nil
+	}
+}
+
+// ZoneDeletionConfirmation confirms the deletion of a zone.
+// Either Confirm must be true, or ZoneName must be the name of the zone to delete.
+type ZoneDeletionConfirmation struct {
+	Confirm  bool
+	ZoneName string
+}
+
+// DeleteZoneConfirmed deletes an existing DNS Zone after checking the confirmation.
+func (c Client) DeleteZoneConfirmed(ctx context.Context, zoneID string, confirmation ZoneDeletionConfirmation) (bool, error) {
+	if c.deletionGuard == deletionGuardDisabled {
+		return false, ErrZoneDeletionDisabled
+	}
+
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	if !confirmation.Confirm {
+		if confirmation.ZoneName == "" {
+			return false, ErrDeletionNotConfirmed
+		}
+
+		zone, err := c.getZone(ctx, zoneID)
+		if err != nil {
+			return false, err
+		}
+
+		if !strings.EqualFold(strings.TrimSuffix(confirmation.ZoneName, "."), zone.Name) {
+			return false, fmt.Errorf("%w: the zone %s is named %s, not %s", ErrDeletionNotConfirmed, zoneID, zone.Name, confirmation.ZoneName)
+		}
+	}
+
+	return c.deleteZone(ctx, zoneID)
+}
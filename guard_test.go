@@ -0,0 +1,50 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DeleteZone_deletionGuard(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone.json"), WithDeletionGuard())
+
+	_, err := client.DeleteZone(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrDeletionNotConfirmed)
+
+	_, err = client.DeleteZoneConfirmed(context.Background(), "xxx", ZoneDeletionConfirmation{})
+	require.ErrorIs(t, err, ErrDeletionNotConfirmed)
+
+	result, err := client.DeleteZoneConfirmed(context.Background(), "xxx", ZoneDeletionConfirmation{Confirm: true})
+	require.NoError(t, err)
+
+	assert.True(t, result)
+}
+
+func TestClient_DeleteZoneConfirmed_zoneName(t *testing.T) {
+	client, mux := setupTestMux(t, WithDeletionGuard())
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID, readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone.json"))
+
+	_, err := client.DeleteZoneConfirmed(context.Background(), sampleZoneID, ZoneDeletionConfirmation{ZoneName: "example.com"})
+	require.ErrorIs(t, err, ErrDeletionNotConfirmed)
+
+	result, err := client.DeleteZoneConfirmed(context.Background(), sampleZoneID, ZoneDeletionConfirmation{ZoneName: "nodionsample.com."})
+	require.NoError(t, err)
+
+	assert.True(t, result)
+}
+
+func TestClient_DeleteZone_deletionDisabled(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone.json"), WithZoneDeletionDisabled())
+
+	_, err := client.DeleteZone(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrZoneDeletionDisabled)
+
+	_, err = client.DeleteZoneConfirmed(context.Background(), "xxx", ZoneDeletionConfirmation{Confirm: true})
+	require.ErrorIs(t, err, ErrZoneDeletionDisabled)
+}
@@ -0,0 +1,160 @@
+// Package history records the mutations performed by a nodion.Client,
+// and allows to roll back the records of a zone to a previous point in time.
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nrdcg/nodion"
+)
+
+// Entry is a mutation recorded in the history.
+type Entry struct {
+	ID        string           `json:"id"`
+	Time      time.Time        `json:"time"`
+	Operation nodion.Operation `json:"operation"`
+	ZoneID    string           `json:"zone_id"`
+	Record    *nodion.Record   `json:"record,omitempty"`
+}
+
+// Store persists the history entries.
+type Store interface {
+	// Append adds an entry to the history.
+	Append(ctx context.Context, entry Entry) error
+	// Entries returns the entries of a zone, sorted by time.
+	Entries(ctx context.Context, zoneID string) ([]Entry, error)
+}
+
+// Recorder records the mutations performed by a client into a Store.
+type Recorder struct {
+	store   Store
+	onError func(error)
+}
+
+// NewRecorder creates a new Recorder.
+// onError is called when an entry cannot be stored, it can be nil.
+func NewRecorder(store Store, onError func(error)) *Recorder {
+	return &Recorder{store: store, onError: onError}
+}
+
+// Hook returns the hook to register on the client with nodion.WithMutationHook.
+// The mutations performed by Rollback are not recorded.
+func (r *Recorder) Hook() nodion.MutationHook {
+	return func(ctx context.Context, mutation nodion.Mutation) {
+		if ctx.Value(rollbackKey{}) != nil {
+			return
+		}
+
+		entry := Entry{
+			ID:        mutation.ID,
+			Time:      mutation.Time,
+			Operation: mutation.Operation,
+			ZoneID:    mutation.ZoneID,
+			Record:    mutation.Record,
+		}
+
+		err := r.store.Append(ctx, entry)
+		if err != nil && r.onError != nil {
+			r.onError(fmt.Errorf("history: append entry: %w", err))
+		}
+	}
+}
+
+// rollbackKey marks the context of the mutations performed by Rollback.
+type rollbackKey struct{}
+
+// Rollback reverts the record mutations of a zone recorded after a point in time,
+// by applying the inverse operations in reverse order.
+// The recreated records have new IDs (and the tags of the deleted records),
+// and the inverse operations are not recorded in the history.
+func Rollback(ctx context.Context, client *nodion.Client, store Store, zoneID string, since time.Time) error {
+	ctx = context.WithValue(ctx, rollbackKey{}, true)
+
+	entries, err := store.Entries(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("history: get entries: %w", err)
+	}
+
+	// the IDs of the recreated records.
+	ids := map[string]string{}
+
+	resolve := func(id string) string {
+		if newID, ok := ids[id]; ok {
+			return newID
+		}
+
+		return id
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+
+		if !entry.Time.After(since) || entry.Record == nil {
+			continue
+		}
+
+		switch entry.Operation {
+		case nodion.OperationCreateRecord:
+			_, err = client.DeleteRecord(ctx, zoneID, resolve(entry.Record.ID))
+			if err != nil {
+				return fmt.Errorf("history: revert entry %s: delete record %s (%s): %w", entry.ID, entry.Record.Name, entry.Record.RecordType, err)
+			}
+
+		case nodion.OperationDeleteRecord:
+			record, errC := client.CreateRecord(ctx, zoneID, nodion.Record{
+				RecordType: entry.Record.RecordType,
+				Name:       entry.Record.Name,
+				Content:    entry.Record.Content,
+				TTL:        entry.Record.TTL,
+				Tags:       entry.Record.Tags,
+			})
+			if errC != nil {
+				return fmt.Errorf("history: revert entry %s: create record %s (%s): %w", entry.ID, entry.Record.Name, entry.Record.RecordType, errC)
+			}
+
+			ids[entry.Record.ID] = record.ID
+		}
+	}
+
+	return nil
+}
+
+// MemoryStore is an in-memory Store.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string][]Entry
+}
+
+// NewMemoryStore creates a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string][]Entry)}
+}
+
+// Append adds an entry to the history.
+func (m *MemoryStore) Append(_ context.Context, entry Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[entry.ZoneID] = append(m.entries[entry.ZoneID], entry)
+
+	return nil
+}
+
+// Entries returns the entries of a zone, sorted by time.
+func (m *MemoryStore) Entries(_ context.Context, zoneID string) ([]Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := make([]Entry, len(m.entries[zoneID]))
+	copy(entries, m.entries[zoneID])
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	return entries, nil
+}
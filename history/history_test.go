@@ -0,0 +1,193 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI is a minimal in-memory implementation of the records endpoints of a zone.
+type fakeAPI struct {
+	mu      sync.Mutex
+	seq     int
+	records map[string]nodion.Record
+}
+
+func (f *fakeAPI) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/dns_zones/zzz/records"), "/")
+
+	switch req.Method {
+	case http.MethodGet:
+		var records []nodion.Record
+		for _, record := range f.records {
+			records = append(records, record)
+		}
+
+		_ = json.NewEncoder(rw).Encode(nodion.RecordsResponse{Records: records})
+
+	case http.MethodPost:
+		var record nodion.Record
+		_ = json.NewDecoder(req.Body).Decode(&record)
+
+		f.seq++
+		record.ID = fmt.Sprintf("id%d", f.seq)
+		f.records[record.ID] = record
+
+		_ = json.NewEncoder(rw).Encode(nodion.RecordResponse{Record: record})
+
+	case http.MethodDelete:
+		if _, ok := f.records[id]; !ok {
+			http.Error(rw, `{"status": 404, "error": "Not Found"}`, http.StatusNotFound)
+			return
+		}
+
+		delete(f.records, id)
+
+		_ = json.NewEncoder(rw).Encode(nodion.DeleteResponse{Deleted: true})
+	}
+}
+
+func (f *fakeAPI) contents() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var contents []string
+	for _, record := range f.records {
+		contents = append(contents, record.Content)
+	}
+
+	return contents
+}
+
+// stepClock is a clock that advances of one minute at each call.
+type stepClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *stepClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(time.Minute)
+
+	return c.now
+}
+
+type sequenceIDGenerator struct {
+	mu  sync.Mutex
+	seq int
+}
+
+func (g *sequenceIDGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.seq++
+
+	return fmt.Sprintf("m%d", g.seq)
+}
+
+func TestRollback(t *testing.T) {
+	api := &fakeAPI{records: map[string]nodion.Record{
+		"orig": {ID: "orig", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	store := NewMemoryStore()
+	recorder := NewRecorder(store, func(err error) { t.Error(err) })
+
+	checkpoint := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	client, err := nodion.NewClient("secret",
+		nodion.WithBaseURL(server.URL),
+		nodion.WithMutationHook(recorder.Hook()),
+		nodion.WithClock(&stepClock{now: checkpoint}),
+		nodion.WithIDGenerator(&sequenceIDGenerator{}),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = client.DeleteRecord(ctx, "zzz", "orig")
+	require.NoError(t, err)
+
+	_, err = client.CreateRecord(ctx, "zzz", nodion.Record{RecordType: nodion.TypeA, Name: "www", Content: "2.2.2.2", TTL: 60})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2.2.2.2"}, api.contents())
+
+	entries, err := store.Entries(ctx, "zzz")
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "m1", entries[0].ID)
+	assert.Equal(t, checkpoint.Add(time.Minute), entries[0].Time)
+	assert.Equal(t, "m2", entries[1].ID)
+	assert.Equal(t, checkpoint.Add(2*time.Minute), entries[1].Time)
+
+	err = Rollback(ctx, client, store, "zzz", checkpoint)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1.1.1.1"}, api.contents())
+
+	// the rollback is not recorded.
+	entries, err = store.Entries(ctx, "zzz")
+	require.NoError(t, err)
+
+	assert.Len(t, entries, 2)
+}
+
+func TestRollback_tags(t *testing.T) {
+	api := &fakeAPI{records: map[string]nodion.Record{
+		"orig": {ID: "orig", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	store := NewMemoryStore()
+	tags := nodion.NewMemoryTagStore()
+
+	checkpoint := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	client, err := nodion.NewClient("secret",
+		nodion.WithBaseURL(server.URL),
+		nodion.WithMutationHook(NewRecorder(store, func(err error) { t.Error(err) }).Hook()),
+		nodion.WithClock(&stepClock{now: checkpoint}),
+		nodion.WithTagStore(tags),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = client.SetRecordTags(ctx, "orig", map[string]string{"owner": "me"})
+	require.NoError(t, err)
+
+	_, err = client.DeleteRecord(ctx, "zzz", "orig")
+	require.NoError(t, err)
+
+	err = Rollback(ctx, client, store, "zzz", checkpoint)
+	require.NoError(t, err)
+
+	records, err := client.GetRecords(ctx, "zzz", &nodion.RecordsFilter{Tags: map[string]string{"owner": "me"}})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, "1.1.1.1", records[0].Content)
+}
@@ -0,0 +1,59 @@
+package nodion
+
+import (
+	"context"
+	"time"
+)
+
+// Operation is the kind of mutation performed by the client.
+type Operation string
+
+// Operations.
+const (
+	OperationCreateZone   Operation = "create_zone"
+	OperationDeleteZone   Operation = "delete_zone"
+	OperationCreateRecord Operation = "create_record"
+	OperationDeleteRecord Operation = "delete_record"
+)
+
+// Mutation describes a successful mutation performed by the client.
+type Mutation struct {
+	// ID is a unique identifier generated by the client (see WithIDGenerator).
+	ID        string
+	Operation Operation
+	Time      time.Time
+	ZoneID    string
+
+	// Zone is the created zone (OperationCreateZone only).
+	Zone *Zone
+
+	// Record is the created record (OperationCreateRecord),
+	// or the record as it was before its deletion (OperationDeleteRecord).
+	Record *Record
+}
+
+// MutationHook is called after each successful mutation.
+type MutationHook func(ctx context.Context, mutation Mutation)
+
+// WithMutationHook registers a hook called after each successful mutation performed by the client.
+// When a hook is registered, the deletion of a record requires an additional call to the API
+// to fetch the record before its deletion.
+func WithMutationHook(hook MutationHook) Option {
+	return func(c *Client) error {
+		c.mutationHooks = append(c.mutationHooks, hook)
+		return nil
+	}
+}
+
+func (c Client) notify(ctx context.Context, mutation Mutation) {
+	if len(c.mutationHooks) == 0 {
+		return
+	}
+
+	mutation.ID = c.idGenerator.NewID()
+	mutation.Time = c.clock.Now()
+
+	for _, hook := range c.mutationHooks {
+		hook(ctx, mutation)
+	}
+}
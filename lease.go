@@ -0,0 +1,286 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors related to the leases.
+var (
+	ErrLeaseHeld = errors.New("lease held by another holder")
+	ErrLeaseLost = errors.New("lease lost")
+)
+
+// DefaultLeaseName is the default name of the TXT record of a lease.
+const DefaultLeaseName = "_nodion-lease"
+
+// LeaseOptions are the options of AcquireLease.
+type LeaseOptions struct {
+	// Name is the name of the TXT record of the lease (default: DefaultLeaseName).
+	Name string
+	// Holder identifies the holder of the lease (e.g. the hostname of the instance), it must not contain spaces.
+	Holder string
+	// Duration is the validity of the lease, from its last renewal.
+	Duration time.Duration
+}
+
+// Lease is a lock on a zone, held by a single holder at a time, e.g. to elect the instance of a controller mutating the zone.
+// The lease is a TXT record with the holder and the expiry: it must be renewed before its expiry (see KeepAlive).
+//
+// The API has no conditional writes: the concurrent acquisitions are resolved after the creation of the records
+// (the oldest valid record wins), so the lease is meant for cooperative holders with a duration much longer than an API call.
+type Lease struct {
+	client Client
+	zoneID string
+	opts   LeaseOptions
+
+	mu     sync.Mutex
+	record Record
+	expiry time.Time
+}
+
+type leaseRecord struct {
+	record Record
+	holder string
+	expiry time.Time
+}
+
+// AcquireLease acquires the lease of a zone.
+// It returns ErrLeaseHeld if another holder has a valid lease.
+// A valid lease of the same holder (e.g. before a restart) is taken over.
+func (c Client) AcquireLease(ctx context.Context, zoneID string, opts LeaseOptions) (*Lease, error) {
+	if opts.Name == "" {
+		opts.Name = DefaultLeaseName
+	}
+
+	if opts.Holder == "" || strings.ContainsAny(opts.Holder, " \t\n") {
+		return nil, fmt.Errorf("invalid lease holder: %q", opts.Holder)
+	}
+
+	if opts.Duration <= 0 {
+		return nil, errors.New("the lease duration must be positive")
+	}
+
+	lease := &Lease{client: c, zoneID: zoneID, opts: opts}
+
+	current, _, err := lease.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if current != nil && current.holder != opts.Holder {
+		return nil, fmt.Errorf("%w: %s until %s", ErrLeaseHeld, current.holder, current.expiry.Format(time.RFC3339))
+	}
+
+	lease.mu.Lock()
+	defer lease.mu.Unlock()
+
+	if current != nil {
+		lease.record = current.record
+	}
+
+	err = lease.renew(ctx)
+	if err != nil {
+		if errors.Is(err, ErrLeaseLost) {
+			return nil, fmt.Errorf("%w (%v)", ErrLeaseHeld, err)
+		}
+
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// Holder returns the holder of the lease.
+func (l *Lease) Holder() string {
+	return l.opts.Holder
+}
+
+// Expiry returns the expiry of the lease.
+func (l *Lease) Expiry() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.expiry
+}
+
+// Renew extends the validity of the lease.
+// It returns ErrLeaseLost if the lease has expired, or is now held by another holder.
+func (l *Lease) Renew(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.renew(ctx)
+}
+
+// KeepAlive renews the lease at the interval, until the context is done or a renewal fails.
+// It returns the error of the renewal, or the context error.
+func (l *Lease) KeepAlive(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("the interval must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		err := l.Renew(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Release releases the lease: its record is deleted.
+func (l *Lease) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.record.ID == "" {
+		return nil
+	}
+
+	_, err := l.client.DeleteRecord(ctx, l.zoneID, l.record.ID)
+	if err != nil {
+		return fmt.Errorf("release lease: %w", err)
+	}
+
+	l.record = Record{}
+	l.expiry = time.Time{}
+
+	return nil
+}
+
+// renew replaces the record of the lease with a new expiry (the new record is created before the deletion of the old one).
+func (l *Lease) renew(ctx context.Context) error {
+	now := l.client.clock.Now()
+
+	if l.record.ID != "" && !l.expiry.IsZero() && !now.Before(l.expiry) {
+		return fmt.Errorf("%w: expired at %s", ErrLeaseLost, l.expiry.Format(time.RFC3339))
+	}
+
+	expiry := now.Add(l.opts.Duration)
+
+	newRecord, err := l.client.CreateRecord(ctx, l.zoneID, Record{
+		RecordType: TypeTXT,
+		Name:       l.opts.Name,
+		Content:    fmt.Sprintf("holder=%s expires=%d", l.opts.Holder, expiry.Unix()),
+		TTL:        60,
+	})
+	if err != nil {
+		return fmt.Errorf("create lease record: %w", err)
+	}
+
+	current, expired, err := l.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current == nil || current.holder != l.opts.Holder {
+		_, _ = l.client.DeleteRecord(ctx, l.zoneID, newRecord.ID)
+
+		if current == nil {
+			return ErrLeaseLost
+		}
+
+		return fmt.Errorf("%w: held by %s", ErrLeaseLost, current.holder)
+	}
+
+	if l.record.ID != "" {
+		_, err = l.client.DeleteRecord(ctx, l.zoneID, l.record.ID)
+		if err != nil {
+			return fmt.Errorf("delete previous lease record: %w", err)
+		}
+	}
+
+	l.record = *newRecord
+	l.expiry = expiry.Truncate(time.Second)
+
+	// the leftovers of the previous holders (best effort).
+	for _, record := range expired {
+		_, _ = l.client.DeleteRecord(ctx, l.zoneID, record.ID)
+	}
+
+	return nil
+}
+
+// current returns the current lease (the oldest valid lease record), nil if there is no valid lease,
+// and the expired lease records.
+// The cache is bypassed.
+func (l *Lease) current(ctx context.Context) (*leaseRecord, []Record, error) {
+	l.client.cache.invalidate(l.zoneID)
+
+	records, err := l.client.GetRecords(ctx, l.zoneID, &RecordsFilter{Name: l.opts.Name, RecordType: TypeTXT})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get lease records: %w", err)
+	}
+
+	now := l.client.clock.Now()
+
+	var valid []leaseRecord
+
+	var expired []Record
+
+	for _, record := range records {
+		lr, ok := parseLeaseRecord(record)
+		if !ok {
+			continue
+		}
+
+		if !now.Before(lr.expiry) {
+			expired = append(expired, record)
+			continue
+		}
+
+		valid = append(valid, lr)
+	}
+
+	if len(valid) == 0 {
+		return nil, expired, nil
+	}
+
+	sort.SliceStable(valid, func(i, j int) bool {
+		if !valid[i].record.CreatedAt.Equal(valid[j].record.CreatedAt) {
+			return valid[i].record.CreatedAt.Before(valid[j].record.CreatedAt)
+		}
+
+		return valid[i].record.ID < valid[j].record.ID
+	})
+
+	return &valid[0], expired, nil
+}
+
+func parseLeaseRecord(record Record) (leaseRecord, bool) {
+	lr := leaseRecord{record: record}
+
+	for _, field := range strings.Fields(JoinTXT(record.Content)) {
+		key, value, _ := strings.Cut(field, "=")
+
+		switch key {
+		case "holder":
+			lr.holder = value
+
+		case "expires":
+			sec, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return leaseRecord{}, false
+			}
+
+			lr.expiry = time.Unix(sec, 0).UTC()
+		}
+	}
+
+	return lr, lr.holder != "" && !lr.expiry.IsZero()
+}
@@ -0,0 +1,83 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AcquireLease(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	client, api := setupFakeAPI(t, nil, WithClock(clock))
+
+	ctx := context.Background()
+
+	lease, err := client.AcquireLease(ctx, "zzz", LeaseOptions{Holder: "instance-a", Duration: time.Minute})
+	require.NoError(t, err)
+
+	assert.Equal(t, clock.now.Add(time.Minute), lease.Expiry())
+	assert.Equal(t, []string{"_nodion-lease txt holder=instance-a expires=1704067260"}, api.snapshot())
+
+	_, err = client.AcquireLease(ctx, "zzz", LeaseOptions{Holder: "instance-b", Duration: time.Minute})
+	require.ErrorIs(t, err, ErrLeaseHeld)
+	require.EqualError(t, err, "lease held by another holder: instance-a until 2024-01-01T00:01:00Z")
+
+	clock.now = clock.now.Add(30 * time.Second)
+
+	err = lease.Renew(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, clock.now.Add(time.Minute), lease.Expiry())
+	assert.Equal(t, []string{"_nodion-lease txt holder=instance-a expires=1704067290"}, api.snapshot())
+
+	// the lease of instance-a expires.
+	clock.now = clock.now.Add(2 * time.Minute)
+
+	other, err := client.AcquireLease(ctx, "zzz", LeaseOptions{Holder: "instance-b", Duration: time.Minute})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"_nodion-lease txt holder=instance-b expires=1704067410"}, api.snapshot())
+
+	err = lease.Renew(ctx)
+	require.ErrorIs(t, err, ErrLeaseLost)
+
+	err = other.Release(ctx)
+	require.NoError(t, err)
+
+	assert.Empty(t, api.snapshot())
+}
+
+func TestClient_AcquireLease_concurrent(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	// instance-a has created its record, but not checked the winner yet.
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeTXT, Name: "_nodion-lease", Content: "holder=instance-a expires=1704067260", TTL: 60},
+	}, WithClock(clock))
+
+	lease := &Lease{client: *client, zoneID: "zzz", opts: LeaseOptions{Name: DefaultLeaseName, Holder: "instance-b", Duration: time.Minute}}
+
+	err := lease.renew(context.Background())
+	require.ErrorIs(t, err, ErrLeaseLost)
+	require.EqualError(t, err, "lease lost: held by instance-a")
+
+	assert.Equal(t, []string{"_nodion-lease txt holder=instance-a expires=1704067260"}, api.snapshot())
+}
+
+func TestClient_AcquireLease_takeOver(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	// the lease of instance-a before a restart.
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeTXT, Name: "_nodion-lease", Content: "holder=instance-a expires=1704067230", TTL: 60},
+	}, WithClock(clock))
+
+	_, err := client.AcquireLease(context.Background(), "zzz", LeaseOptions{Holder: "instance-a", Duration: time.Minute})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"_nodion-lease txt holder=instance-a expires=1704067260"}, api.snapshot())
+}
@@ -0,0 +1,111 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+)
+
+// ListOptions are the pagination options of ListZones and ListRecords.
+type ListOptions struct {
+	// Page is the page number, starting at 1 (defaults to 1).
+	Page int
+	// PerPage is the number of items per page (0 means all the items in a single page).
+	PerPage int
+}
+
+// ListMeta describes a page of a list.
+type ListMeta struct {
+	Page       int
+	PerPage    int
+	TotalCount int
+	TotalPages int
+}
+
+// ZoneList is a page of zones.
+type ZoneList struct {
+	Zones []Zone
+	ListMeta
+}
+
+// RecordList is a page of records.
+type RecordList struct {
+	Records []Record
+	ListMeta
+}
+
+// ListZones lists a page of zones.
+// The API returns all the zones in a single response: the pagination is done client-side,
+// it should be combined with a sort (see ZonesFilter) to get a stable order.
+func (c Client) ListZones(ctx context.Context, filter *ZonesFilter, opts ListOptions) (*ZoneList, error) {
+	err := opts.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := c.GetZones(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, start, end := opts.paginate(len(zones))
+
+	return &ZoneList{Zones: zones[start:end], ListMeta: meta}, nil
+}
+
+// ListRecords lists a page of records of a zone.
+// The API returns all the records in a single response: the pagination is done client-side,
+// it should be combined with a sort (see RecordsFilter) to get a stable order.
+func (c Client) ListRecords(ctx context.Context, zoneID string, filter *RecordsFilter, opts ListOptions) (*RecordList, error) {
+	err := opts.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, start, end := opts.paginate(len(records))
+
+	return &RecordList{Records: records[start:end], ListMeta: meta}, nil
+}
+
+func (o ListOptions) validate() error {
+	if o.Page < 0 || o.PerPage < 0 {
+		return errors.New("the page and the number of items per page must be positive")
+	}
+
+	return nil
+}
+
+// paginate returns the metadata of the page, and the bounds of the page in the full list.
+func (o ListOptions) paginate(total int) (ListMeta, int, int) {
+	meta := ListMeta{Page: o.Page, PerPage: o.PerPage, TotalCount: total}
+
+	if meta.Page == 0 {
+		meta.Page = 1
+	}
+
+	if meta.PerPage == 0 {
+		meta.PerPage = total
+	}
+
+	if meta.PerPage == 0 {
+		return meta, 0, 0
+	}
+
+	meta.TotalPages = (total + meta.PerPage - 1) / meta.PerPage
+
+	start := (meta.Page - 1) * meta.PerPage
+	if start > total {
+		start = total
+	}
+
+	end := start + meta.PerPage
+	if end > total {
+		end = total
+	}
+
+	return meta, start, end
+}
@@ -0,0 +1,60 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListRecords(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	filter := &RecordsFilter{SortBy: SortByName}
+
+	list, err := client.ListRecords(context.Background(), "xxx", filter, ListOptions{Page: 2, PerPage: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, ListMeta{Page: 2, PerPage: 2, TotalCount: 5, TotalPages: 3}, list.ListMeta)
+	assert.Equal(t, []string{"@", "@"}, recordNames(list.Records))
+
+	list, err = client.ListRecords(context.Background(), "xxx", filter, ListOptions{Page: 3, PerPage: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www"}, recordNames(list.Records))
+
+	list, err = client.ListRecords(context.Background(), "xxx", filter, ListOptions{Page: 4, PerPage: 2})
+	require.NoError(t, err)
+
+	assert.Empty(t, list.Records)
+
+	list, err = client.ListRecords(context.Background(), "xxx", filter, ListOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, ListMeta{Page: 1, PerPage: 5, TotalCount: 5, TotalPages: 1}, list.ListMeta)
+	assert.Len(t, list.Records, 5)
+
+	_, err = client.ListRecords(context.Background(), "xxx", filter, ListOptions{Page: -1})
+	require.Error(t, err)
+}
+
+func TestClient_ListZones(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+
+	list, err := client.ListZones(context.Background(), nil, ListOptions{PerPage: 1})
+	require.NoError(t, err)
+
+	require.Len(t, list.Zones, 1)
+	assert.Equal(t, 1, list.Page)
+	assert.Equal(t, list.TotalCount, list.TotalPages)
+}
+
+func TestListOptions_paginate_empty(t *testing.T) {
+	meta, start, end := ListOptions{}.paginate(0)
+
+	assert.Equal(t, ListMeta{Page: 1}, meta)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 0, end)
+}
@@ -0,0 +1,78 @@
+package nodion
+
+import (
+	"context"
+	"sync"
+)
+
+// WithZoneLocking serializes the mutations of the client per zone, even when they are made by several goroutines:
+// each mutation (CreateRecord, DeleteRecord, DeleteZone, SetRecordSet, ChangeSet.Commit, ...) holds the lock of its zone
+// during the whole operation, including its checks (conflicts, ownership, snapshots, ...).
+// The nested mutations of an operation don't lock the zone again.
+func WithZoneLocking() Option {
+	return func(c *Client) error {
+		c.zoneLocks = &zoneLocks{locks: make(map[string]*zoneLock)}
+		return nil
+	}
+}
+
+type zoneLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// zoneLocks is a set of mutexes by zone ID.
+// A nil zoneLocks doesn't lock.
+type zoneLocks struct {
+	mu    sync.Mutex
+	locks map[string]*zoneLock
+}
+
+// lock locks the zone, and returns the function to unlock it.
+func (z *zoneLocks) lock(zoneID string) func() {
+	if z == nil || zoneID == "" {
+		return func() {}
+	}
+
+	z.mu.Lock()
+
+	l, ok := z.locks[zoneID]
+	if !ok {
+		l = &zoneLock{}
+		z.locks[zoneID] = l
+	}
+
+	l.refs++
+
+	z.mu.Unlock()
+
+	l.mu.Lock()
+
+	return func() {
+		l.mu.Unlock()
+
+		z.mu.Lock()
+		defer z.mu.Unlock()
+
+		l.refs--
+		if l.refs == 0 {
+			delete(z.locks, zoneID)
+		}
+	}
+}
+
+type zoneLockKey struct {
+	zoneID string
+}
+
+// lockZone locks a zone for a whole operation,
+// and returns the context of the operation (the zone is not locked again by the calls using it) and the function to unlock the zone.
+func (c Client) lockZone(ctx context.Context, zoneID string) (context.Context, func()) {
+	if c.zoneLocks == nil || zoneID == "" || ctx.Value(zoneLockKey{zoneID: zoneID}) != nil {
+		return ctx, func() {}
+	}
+
+	unlock := c.zoneLocks.lock(zoneID)
+
+	return context.WithValue(ctx, zoneLockKey{zoneID: zoneID}, true), unlock
+}
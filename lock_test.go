@@ -0,0 +1,177 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_zoneLocking(t *testing.T) {
+	client, server := setupTestMux(t, WithZoneLocking())
+
+	var inFlight, maxInFlight atomic.Int32
+
+	api := &fakeAPI{records: map[string]Record{}}
+
+	server.HandleFunc("/dns_zones/zzz/records", func(rw http.ResponseWriter, req *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		api.ServeHTTP(rw, req)
+	})
+
+	const n = 10
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, n)
+
+	for i := 0; i < n; i++ {
+		i := i
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeTXT, Name: fmt.Sprintf("r%d", i), Content: "foo", TTL: 60})
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	assert.EqualValues(t, 1, maxInFlight.Load())
+	assert.Len(t, api.snapshot(), n)
+}
+
+func TestClient_zoneLocking_checkThenCreate(t *testing.T) {
+	client, server := setupTestMux(t, WithZoneLocking(), WithCNAMEConflictCheck())
+
+	api := &fakeAPI{records: map[string]Record{}}
+
+	server.HandleFunc("/dns_zones", func(rw http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(rw).Encode(ZonesResponse{Zones: []Zone{{ID: "zzz", Name: "example.com"}}})
+	})
+	server.HandleFunc("/dns_zones/zzz/records", func(rw http.ResponseWriter, req *http.Request) {
+		// without the lock, both conflict checks run before the creations.
+		if req.Method == http.MethodGet {
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		api.ServeHTTP(rw, req)
+	})
+
+	records := []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "example.org", TTL: 60},
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, len(records))
+
+	for _, record := range records {
+		record := record
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.CreateRecord(context.Background(), "zzz", record)
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var conflicts int
+
+	for err := range errs {
+		if err != nil {
+			require.ErrorIs(t, err, ErrRecordConflict)
+			conflicts++
+		}
+	}
+
+	assert.Equal(t, 1, conflicts)
+	assert.Len(t, api.snapshot(), 1)
+}
+
+func TestClient_lockZone_nested(t *testing.T) {
+	client, err := NewClient("secret", WithZoneLocking())
+	require.NoError(t, err)
+
+	ctx, unlock := client.lockZone(context.Background(), "zzz")
+
+	done := make(chan struct{})
+
+	go func() {
+		_, unlockNested := client.lockZone(ctx, "zzz")
+		unlockNested()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("deadlock of the nested lock")
+	}
+
+	unlock()
+}
+
+func Test_zoneLocks(t *testing.T) {
+	locks := &zoneLocks{locks: make(map[string]*zoneLock)}
+
+	unlockA := locks.lock("a")
+	unlockB := locks.lock("b")
+
+	acquired := make(chan struct{})
+	released := make(chan struct{})
+
+	go func() {
+		unlock := locks.lock("a")
+		close(acquired)
+		unlock()
+		close(released)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("the zone is not locked")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	unlockA()
+	<-acquired
+	<-released
+
+	unlockB()
+
+	assert.Empty(t, locks.locks)
+}
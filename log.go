@@ -0,0 +1,174 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// WithLogger logs the activity of the client with a slog.Handler:
+//   - at the info level, the mutations (see MutationHook),
+//   - at the debug level, the HTTP traffic (requests and responses, with their headers and bodies).
+//
+// The Authorization header and the values of the ACME challenges (TXT records `_acme-challenge`) are redacted.
+// As with WithMutationHook, the deletion of a record requires an additional call to the API.
+func WithLogger(handler slog.Handler) Option {
+	return func(c *Client) error {
+		c.logger = slog.New(handler)
+		c.mutationHooks = append(c.mutationHooks, logMutation(c.logger))
+
+		return nil
+	}
+}
+
+func logMutation(logger *slog.Logger) MutationHook {
+	return func(ctx context.Context, mutation Mutation) {
+		attrs := []slog.Attr{
+			slog.String("id", mutation.ID),
+			slog.String("operation", string(mutation.Operation)),
+			slog.String("zone_id", mutation.ZoneID),
+		}
+
+		if mutation.Zone != nil {
+			attrs = append(attrs, slog.String("zone", mutation.Zone.Name))
+		}
+
+		if mutation.Record != nil {
+			attrs = append(attrs, slog.Group("record",
+				slog.String("id", mutation.Record.ID),
+				slog.String("type", string(mutation.Record.RecordType)),
+				slog.String("name", mutation.Record.Name),
+				slog.String("content", redactContent(mutation.Record.Name, mutation.Record.Content)),
+				slog.Int("ttl", mutation.Record.TTL),
+			))
+		}
+
+		logger.LogAttrs(ctx, slog.LevelInfo, "mutation", attrs...)
+	}
+}
+
+// logRequest logs an HTTP exchange at the debug level.
+func (c Client) logRequest(req *http.Request, resp *http.Response, start time.Time, err error) {
+	if c.logger == nil || !c.logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Any("header", redactHeader(req.Header)),
+		slog.Duration("duration", time.Since(start)),
+	}
+
+	if req.GetBody != nil {
+		body, errB := req.GetBody()
+		if errB == nil {
+			raw, _ := io.ReadAll(body)
+			_ = body.Close()
+
+			if len(raw) > 0 {
+				attrs = append(attrs, slog.String("body", redactBody(raw)))
+			}
+		}
+	}
+
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelDebug, "api request", attrs...)
+}
+
+// logResponse logs the body of a response at the debug level.
+func (c Client) logResponse(req *http.Request, statusCode int, raw []byte) {
+	if c.logger == nil || !c.logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelDebug, "api response",
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("status", statusCode),
+		slog.String("body", redactBody(raw)),
+	)
+}
+
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+
+	if header.Get("Authorization") != "" {
+		header.Set("Authorization", redacted)
+	}
+
+	return header
+}
+
+// redactBody redacts the contents of the ACME challenges of a JSON body.
+// A body that is not JSON is returned as is.
+func redactBody(raw []byte) string {
+	var v any
+
+	err := json.Unmarshal(raw, &v)
+	if err != nil {
+		return string(raw)
+	}
+
+	if !redactValue(v) {
+		return string(raw)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return string(raw)
+	}
+
+	return string(data)
+}
+
+// redactValue redacts the contents of the records of ACME challenges in a decoded JSON value,
+// and reports whether a content has been redacted.
+func redactValue(v any) bool {
+	var changed bool
+
+	switch value := v.(type) {
+	case map[string]any:
+		name, _ := value["name"].(string)
+		if content, ok := value["content"].(string); ok && redactContent(name, content) != content {
+			value["content"] = redacted
+			changed = true
+		}
+
+		for _, item := range value {
+			changed = redactValue(item) || changed
+		}
+
+	case []any:
+		for _, item := range value {
+			changed = redactValue(item) || changed
+		}
+	}
+
+	return changed
+}
+
+func redactContent(name, content string) string {
+	if isACMEChallenge(name) {
+		return redacted
+	}
+
+	return content
+}
+
+func isACMEChallenge(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), "_acme-challenge")
+}
@@ -0,0 +1,67 @@
+package nodion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+
+	client, _ := setupFakeAPI(t, nil, WithLogger(handler))
+
+	record := Record{RecordType: TypeTXT, Name: "_acme-challenge.www", Content: "secret-token", TTL: 60}
+
+	_, err := client.CreateRecord(context.Background(), "zzz", record)
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "secret-token")
+	assert.NotContains(t, buf.String(), "Bearer")
+
+	var messages []string
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+
+		messages = append(messages, entry["level"].(string)+" "+entry["msg"].(string))
+	}
+
+	assert.Equal(t, []string{"DEBUG api request", "DEBUG api response", "INFO mutation"}, messages)
+}
+
+func TestWithLogger_info(t *testing.T) {
+	var buf bytes.Buffer
+
+	client, _ := setupFakeAPI(t, nil, WithLogger(slog.NewTextHandler(&buf, nil)))
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600})
+	require.NoError(t, err)
+
+	_, err = client.GetRecords(context.Background(), "zzz", nil)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+
+	assert.Contains(t, lines[0], "operation=create_record zone_id=zzz record.id=id1 record.type=a record.name=www record.content=192.0.2.1 record.ttl=3600")
+}
+
+func Test_redactBody(t *testing.T) {
+	body := `{"records":[{"name":"_acme-challenge","content":"abc"},{"name":"www","content":"192.0.2.1"}]}`
+
+	assert.Equal(t, `{"records":[{"content":"REDACTED","name":"_acme-challenge"},{"content":"192.0.2.1","name":"www"}]}`, redactBody([]byte(body)))
+
+	assert.Equal(t, `{"name":"www","content":"x"}`, redactBody([]byte(`{"name":"www","content":"x"}`)))
+	assert.Equal(t, "not json", redactBody([]byte("not json")))
+}
@@ -0,0 +1,115 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+)
+
+// TTLSnapshot is the original TTLs of the record sets lowered by LowerTTLs.
+// It can be persisted (e.g. as JSON) until the end of the migration window, and restored with RestoreTTLs.
+type TTLSnapshot struct {
+	ZoneID string `json:"zone_id"`
+	// TTL is the lowered TTL.
+	TTL int `json:"ttl"`
+	// RecordSets are the lowered record sets, with their original TTLs.
+	RecordSets []TTLRecordSet `json:"record_sets,omitempty"`
+}
+
+// TTLRecordSet is the original TTL of a record set (the records with the same name and type).
+type TTLRecordSet struct {
+	Name       string     `json:"name"`
+	RecordType RecordType `json:"record_type"`
+	TTL        int        `json:"ttl"`
+}
+
+// LowerTTLs lowers the TTL of the records matching a filter (e.g. before a migration),
+// the records with a TTL lower than or equal to ttl are left untouched.
+// The changes are applied with a ChangeSet: if a change fails, the applied changes are rolled back.
+// It returns the original TTLs, to restore with RestoreTTLs after the migration.
+func (c Client) LowerTTLs(ctx context.Context, zoneID string, filter *RecordsFilter, ttl int) (*TTLSnapshot, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &TTLSnapshot{ZoneID: zoneID, TTL: ttl}
+
+	sets := map[string]int{}
+
+	changeSet := c.BeginChangeSet(zoneID)
+
+	for _, record := range records {
+		if record.TTL <= ttl {
+			continue
+		}
+
+		key := recordSetKey(zone.Name, record)
+
+		i, ok := sets[key]
+		if !ok {
+			i = len(snapshot.RecordSets)
+			sets[key] = i
+
+			snapshot.RecordSets = append(snapshot.RecordSets, TTLRecordSet{
+				Name:       RelativeName(record.Name, zone.Name),
+				RecordType: record.RecordType,
+			})
+		}
+
+		snapshot.RecordSets[i].TTL = max(snapshot.RecordSets[i].TTL, record.TTL)
+
+		changeSet.Update(record, Record{RecordType: record.RecordType, Name: record.Name, Content: record.Content, TTL: ttl})
+	}
+
+	_, err = changeSet.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// RestoreTTLs restores the original TTLs of the record sets lowered by LowerTTLs.
+// The records of the record sets are matched by name and type, so the records changed during the migration are restored too,
+// but only the records still with the lowered TTL are changed.
+// The changes are applied with a ChangeSet: if a change fails, the applied changes are rolled back.
+// It returns the restored records.
+func (c Client) RestoreTTLs(ctx context.Context, snapshot *TTLSnapshot) ([]Record, error) {
+	zone, err := c.getZone(ctx, snapshot.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, snapshot.ZoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	original := map[string]int{}
+	for _, set := range snapshot.RecordSets {
+		original[recordSetKey(zone.Name, Record{Name: set.Name, RecordType: set.RecordType})] = set.TTL
+	}
+
+	changeSet := c.BeginChangeSet(snapshot.ZoneID)
+
+	for _, record := range records {
+		ttl, ok := original[recordSetKey(zone.Name, record)]
+		if !ok || record.TTL != snapshot.TTL {
+			continue
+		}
+
+		changeSet.Update(record, Record{RecordType: record.RecordType, Name: record.Name, Content: record.Content, TTL: ttl})
+	}
+
+	restored, err := changeSet.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restore TTLs: %w", err)
+	}
+
+	return restored, nil
+}
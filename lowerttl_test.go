@@ -0,0 +1,91 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_LowerTTLs(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.2", TTL: 3600},
+		{RecordType: TypeA, Name: "api", Content: "192.0.2.3", TTL: 30},
+		{RecordType: TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 86400},
+	})
+
+	ctx := context.Background()
+
+	snapshot, err := client.LowerTTLs(ctx, "zzz", &RecordsFilter{RecordType: TypeA}, 60)
+	require.NoError(t, err)
+
+	expected := &TTLSnapshot{
+		ZoneID:     "zzz",
+		TTL:        60,
+		RecordSets: []TTLRecordSet{{Name: "www", RecordType: TypeA, TTL: 3600}},
+	}
+
+	assert.Equal(t, expected, snapshot)
+	assert.Equal(t, []int{30, 86400, 60, 60}, recordTTLs(api.sorted()))
+
+	// the snapshot can be persisted during the migration window.
+	raw, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"zone_id": "zzz", "ttl": 60, "record_sets": [{"name": "www", "record_type": "a", "ttl": 3600}]}`, string(raw))
+
+	var restoredSnapshot TTLSnapshot
+
+	err = json.Unmarshal(raw, &restoredSnapshot)
+	require.NoError(t, err)
+
+	// the migration.
+	_, err = client.Cutover(ctx, "zzz", map[string]string{"192.0.2.2": "198.51.100.2"}, CutoverOptions{})
+	require.NoError(t, err)
+
+	restored, err := client.RestoreTTLs(ctx, &restoredSnapshot)
+	require.NoError(t, err)
+
+	assert.Len(t, restored, 2)
+
+	expectedRecords := []string{
+		"api a 192.0.2.3",
+		"@ mx 10 mail.example.com",
+		"www a 192.0.2.1",
+		"www a 198.51.100.2",
+	}
+
+	assert.Equal(t, expectedRecords, api.snapshot())
+	assert.Equal(t, []int{30, 86400, 3600, 3600}, recordTTLs(api.sorted()))
+}
+
+func TestClient_LowerTTLs_cname(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "example.org", TTL: 3600},
+	}, WithCNAMEConflictCheck())
+
+	ctx := context.Background()
+
+	snapshot, err := client.LowerTTLs(ctx, "zzz", nil, 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{60}, recordTTLs(api.sorted()))
+
+	_, err = client.RestoreTTLs(ctx, snapshot)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www cname example.org"}, api.snapshot())
+	assert.Equal(t, []int{3600}, recordTTLs(api.sorted()))
+}
+
+func recordTTLs(records []Record) []int {
+	var ttls []int
+	for _, record := range records {
+		ttls = append(ttls, record.TTL)
+	}
+
+	return ttls
+}
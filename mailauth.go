@@ -0,0 +1,355 @@
+package nodion
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// SPFQualifier is the qualifier of the `all` mechanism of an SPF record.
+type SPFQualifier string
+
+// SPF qualifiers.
+const (
+	SPFFail     SPFQualifier = "-"
+	SPFSoftFail SPFQualifier = "~"
+	SPFNeutral  SPFQualifier = "?"
+	SPFPass     SPFQualifier = "+"
+)
+
+// SPF is the content of an SPF record (RFC 7208).
+type SPF struct {
+	A       bool
+	MX      bool
+	IP4     []string
+	IP6     []string
+	Include []string
+	// All is the qualifier of the final `all` mechanism (SPFFail by default), ignored if Redirect is set.
+	All      SPFQualifier
+	Redirect string
+}
+
+// Build returns the content of the SPF record.
+func (s SPF) Build() (string, error) {
+	terms := []string{"v=spf1"}
+
+	if s.A {
+		terms = append(terms, "a")
+	}
+
+	if s.MX {
+		terms = append(terms, "mx")
+	}
+
+	for _, ip := range s.IP4 {
+		prefix, err := parseIPOrPrefix(ip)
+		if err != nil || !prefix.Addr().Is4() {
+			return "", fmt.Errorf("invalid SPF ip4: %q", ip)
+		}
+
+		terms = append(terms, "ip4:"+ip)
+	}
+
+	for _, ip := range s.IP6 {
+		prefix, err := parseIPOrPrefix(ip)
+		if err != nil || !prefix.Addr().Is6() {
+			return "", fmt.Errorf("invalid SPF ip6: %q", ip)
+		}
+
+		terms = append(terms, "ip6:"+ip)
+	}
+
+	for _, domain := range s.Include {
+		if strings.TrimSpace(domain) == "" || strings.ContainsAny(domain, " \t") {
+			return "", fmt.Errorf("invalid SPF include: %q", domain)
+		}
+
+		terms = append(terms, "include:"+domain)
+	}
+
+	if s.Redirect != "" {
+		terms = append(terms, "redirect="+s.Redirect)
+	} else {
+		qualifier := s.All
+		if qualifier == "" {
+			qualifier = SPFFail
+		}
+
+		switch qualifier {
+		case SPFFail, SPFSoftFail, SPFNeutral, SPFPass:
+		default:
+			return "", fmt.Errorf("invalid SPF qualifier: %q", qualifier)
+		}
+
+		terms = append(terms, string(qualifier)+"all")
+	}
+
+	content := strings.Join(terms, " ")
+
+	for _, problem := range checkSPF(content) {
+		if strings.HasPrefix(problem, "too many") {
+			return "", fmt.Errorf("invalid SPF record: %s", problem)
+		}
+	}
+
+	return content, nil
+}
+
+// DKIM is the content of a DKIM public key record (RFC 6376).
+type DKIM struct {
+	// Selector is the selector of the key (the record name is `<selector>._domainkey`).
+	Selector string
+	// KeyType is the type of the key: rsa (default) or ed25519.
+	KeyType string
+	// PublicKey is the base64 encoded public key.
+	PublicKey string
+	// Testing indicates that the domain is testing DKIM (flag `t=y`).
+	Testing bool
+}
+
+// Name returns the name of the record, relative to the zone.
+func (d DKIM) Name() string {
+	return d.Selector + "._domainkey"
+}
+
+// Build returns the content of the DKIM record.
+func (d DKIM) Build() (string, error) {
+	if d.Selector == "" || strings.ContainsAny(d.Selector, " \t") {
+		return "", fmt.Errorf("invalid DKIM selector: %q", d.Selector)
+	}
+
+	keyType := d.KeyType
+	if keyType == "" {
+		keyType = "rsa"
+	}
+
+	if keyType != "rsa" && keyType != "ed25519" {
+		return "", fmt.Errorf("invalid DKIM key type: %q", d.KeyType)
+	}
+
+	key := strings.Join(strings.Fields(d.PublicKey), "")
+
+	_, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || key == "" {
+		return "", errors.New("invalid DKIM public key: base64 expected")
+	}
+
+	tags := []string{"v=DKIM1", "k=" + keyType}
+
+	if d.Testing {
+		tags = append(tags, "t=y")
+	}
+
+	tags = append(tags, "p="+key)
+
+	return strings.Join(tags, "; "), nil
+}
+
+// DMARCPolicy is the policy of a DMARC record.
+type DMARCPolicy string
+
+// DMARC policies.
+const (
+	DMARCNone       DMARCPolicy = "none"
+	DMARCQuarantine DMARCPolicy = "quarantine"
+	DMARCReject     DMARCPolicy = "reject"
+)
+
+// DMARC is the content of a DMARC record (RFC 7489).
+type DMARC struct {
+	Policy DMARCPolicy
+	// SubdomainPolicy is the policy of the subdomains (the policy of the domain if empty).
+	SubdomainPolicy DMARCPolicy
+	// Percent is the percentage of messages subjected to the policy (100 if 0).
+	Percent int
+	// AggregateReports are the addresses receiving the aggregate reports (`mailto:` is added if needed).
+	AggregateReports []string
+	// ForensicReports are the addresses receiving the failure reports (`mailto:` is added if needed).
+	ForensicReports []string
+	// StrictSPF and StrictDKIM enable the strict alignment modes.
+	StrictSPF  bool
+	StrictDKIM bool
+}
+
+// Name returns the name of the record, relative to the zone.
+func (DMARC) Name() string {
+	return "_dmarc"
+}
+
+// Build returns the content of the DMARC record.
+func (d DMARC) Build() (string, error) {
+	if !validDMARCPolicy(d.Policy) {
+		return "", fmt.Errorf("invalid DMARC policy: %q", d.Policy)
+	}
+
+	tags := []string{"v=DMARC1", "p=" + string(d.Policy)}
+
+	if d.SubdomainPolicy != "" {
+		if !validDMARCPolicy(d.SubdomainPolicy) {
+			return "", fmt.Errorf("invalid DMARC subdomain policy: %q", d.SubdomainPolicy)
+		}
+
+		tags = append(tags, "sp="+string(d.SubdomainPolicy))
+	}
+
+	if d.Percent != 0 {
+		if d.Percent < 0 || d.Percent > 100 {
+			return "", fmt.Errorf("invalid DMARC percentage: %d", d.Percent)
+		}
+
+		tags = append(tags, fmt.Sprintf("pct=%d", d.Percent))
+	}
+
+	if len(d.AggregateReports) > 0 {
+		tags = append(tags, "rua="+mailtoList(d.AggregateReports))
+	}
+
+	if len(d.ForensicReports) > 0 {
+		tags = append(tags, "ruf="+mailtoList(d.ForensicReports))
+	}
+
+	if d.StrictSPF {
+		tags = append(tags, "aspf=s")
+	}
+
+	if d.StrictDKIM {
+		tags = append(tags, "adkim=s")
+	}
+
+	return strings.Join(tags, "; "), nil
+}
+
+// MailAuth are the mail authentication records of a domain.
+type MailAuth struct {
+	SPF   *SPF
+	DKIM  []DKIM
+	DMARC *DMARC
+	// TTL is the TTL of the records.
+	TTL int
+}
+
+// SetMailAuthRecords sets the SPF (apex), DKIM and DMARC records of a zone:
+// the existing SPF record of the apex, and the existing records of the DKIM and DMARC names, are replaced.
+// The other TXT records of the apex are left untouched.
+// It returns the resulting records.
+func (c Client) SetMailAuthRecords(ctx context.Context, zoneID string, auth MailAuth) ([]Record, error) {
+	var result []Record
+
+	if auth.SPF != nil {
+		content, err := auth.SPF.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := c.setSPF(ctx, zoneID, content, auth.TTL)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, records...)
+	}
+
+	for _, dkim := range auth.DKIM {
+		content, err := dkim.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := c.SetRecordSet(ctx, zoneID, dkim.Name(), TypeTXT, []string{content}, auth.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("set DKIM record %s: %w", dkim.Name(), err)
+		}
+
+		result = append(result, records...)
+	}
+
+	if auth.DMARC != nil {
+		content, err := auth.DMARC.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := c.SetRecordSet(ctx, zoneID, auth.DMARC.Name(), TypeTXT, []string{content}, auth.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("set DMARC record: %w", err)
+		}
+
+		result = append(result, records...)
+	}
+
+	return result, nil
+}
+
+// setSPF replaces the SPF records of the apex.
+func (c Client) setSPF(ctx context.Context, zoneID, content string, ttl int) ([]Record, error) {
+	existing, err := c.GetRecordSet(ctx, zoneID, "@", TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept, toDelete []Record
+
+	for _, record := range existing {
+		if !strings.HasPrefix(strings.ToLower(JoinTXT(record.Content)), "v=spf1") {
+			continue
+		}
+
+		if JoinTXT(record.Content) == content && record.TTL == ttl && len(kept) == 0 {
+			kept = append(kept, record)
+			continue
+		}
+
+		toDelete = append(toDelete, record)
+	}
+
+	changeSet := c.BeginChangeSet(zoneID)
+
+	if len(kept) == 0 {
+		changeSet.Add(Record{RecordType: TypeTXT, Name: "@", Content: content, TTL: ttl})
+	}
+
+	for _, record := range toDelete {
+		changeSet.Delete(record)
+	}
+
+	created, err := changeSet.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("set SPF record: %w", err)
+	}
+
+	return append(kept, created...), nil
+}
+
+func validDMARCPolicy(policy DMARCPolicy) bool {
+	return policy == DMARCNone || policy == DMARCQuarantine || policy == DMARCReject
+}
+
+func mailtoList(addresses []string) string {
+	uris := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		if !strings.HasPrefix(address, "mailto:") {
+			address = "mailto:" + address
+		}
+
+		uris = append(uris, address)
+	}
+
+	return strings.Join(uris, ",")
+}
+
+func parseIPOrPrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
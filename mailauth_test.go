@@ -0,0 +1,85 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPF_Build(t *testing.T) {
+	content, err := SPF{MX: true, IP4: []string{"192.0.2.0/24"}, IP6: []string{"2001:db8::1"}, Include: []string{"_spf.google.com"}, All: SPFSoftFail}.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "v=spf1 mx ip4:192.0.2.0/24 ip6:2001:db8::1 include:_spf.google.com ~all", content)
+
+	content, err = SPF{Redirect: "_spf.example.com"}.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "v=spf1 redirect=_spf.example.com", content)
+
+	_, err = SPF{IP4: []string{"2001:db8::1"}}.Build()
+	require.EqualError(t, err, `invalid SPF ip4: "2001:db8::1"`)
+
+	_, err = SPF{All: "x"}.Build()
+	require.EqualError(t, err, `invalid SPF qualifier: "x"`)
+}
+
+func TestDKIM_Build(t *testing.T) {
+	dkim := DKIM{Selector: "mail", PublicKey: "MIGfMA0G\nCSqGSIb3"}
+
+	content, err := dkim.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3", content)
+	assert.Equal(t, "mail._domainkey", dkim.Name())
+
+	_, err = DKIM{Selector: "mail", PublicKey: "not base64!"}.Build()
+	require.EqualError(t, err, "invalid DKIM public key: base64 expected")
+
+	_, err = DKIM{Selector: "mail", KeyType: "dsa", PublicKey: "MIGf"}.Build()
+	require.EqualError(t, err, `invalid DKIM key type: "dsa"`)
+}
+
+func TestDMARC_Build(t *testing.T) {
+	content, err := DMARC{
+		Policy:           DMARCQuarantine,
+		SubdomainPolicy:  DMARCReject,
+		Percent:          50,
+		AggregateReports: []string{"dmarc@example.com", "mailto:reports@example.org"},
+		StrictSPF:        true,
+	}.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "v=DMARC1; p=quarantine; sp=reject; pct=50; rua=mailto:dmarc@example.com,mailto:reports@example.org; aspf=s", content)
+
+	_, err = DMARC{Policy: "block"}.Build()
+	require.EqualError(t, err, `invalid DMARC policy: "block"`)
+}
+
+func TestClient_SetMailAuthRecords(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeTXT, Name: "@", Content: "google-site-verification=xxx", TTL: 3600},
+		{RecordType: TypeTXT, Name: "@", Content: "v=spf1 a -all", TTL: 3600},
+		{RecordType: TypeTXT, Name: "_dmarc", Content: "v=DMARC1; p=none", TTL: 3600},
+	})
+
+	records, err := client.SetMailAuthRecords(context.Background(), "zzz", MailAuth{
+		SPF:   &SPF{MX: true},
+		DKIM:  []DKIM{{Selector: "s1", PublicKey: "MIGf"}},
+		DMARC: &DMARC{Policy: DMARCReject},
+		TTL:   3600,
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, records, 3)
+
+	expected := []string{
+		"@ txt google-site-verification=xxx",
+		"@ txt v=spf1 mx -all",
+		`s1._domainkey txt "v=DKIM1; k=rsa; p=MIGf"`,
+		`_dmarc txt "v=DMARC1; p=reject"`,
+	}
+	assert.Equal(t, expected, api.snapshot())
+}
@@ -0,0 +1,65 @@
+package nodion
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// jsonZone is the JSON representation of a Zone.
+// The fields are declared in the order of the JSON output: the order doesn't depend on the layout of Zone.
+type jsonZone struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	CreatedAt string   `json:"created_at,omitempty"`
+	UpdatedAt string   `json:"updated_at,omitempty"`
+	Records   []Record `json:"records,omitempty"`
+}
+
+// jsonRecord is the JSON representation of a Record.
+// The fields are declared in the order of the JSON output: the order doesn't depend on the layout of Record.
+type jsonRecord struct {
+	ID         string     `json:"id,omitempty"`
+	RecordType RecordType `json:"record_type,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	Content    string     `json:"content,omitempty"`
+	TTL        int        `json:"ttl,omitempty"`
+	ZoneID     string     `json:"zone_id,omitempty"`
+	CreatedAt  string     `json:"created_at,omitempty"`
+	UpdatedAt  string     `json:"updated_at,omitempty"`
+}
+
+// MarshalJSON encodes the zone with a stable field order and canonical timestamps (see formatTimestamp).
+func (z Zone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonZone{
+		ID:        z.ID,
+		Name:      z.Name,
+		CreatedAt: formatTimestamp(z.CreatedAt),
+		UpdatedAt: formatTimestamp(z.UpdatedAt),
+		Records:   z.Records,
+	})
+}
+
+// MarshalJSON encodes the record with a stable field order and canonical timestamps (see formatTimestamp).
+// The tags are not encoded.
+func (r Record) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonRecord{
+		ID:         r.ID,
+		RecordType: r.RecordType,
+		Name:       r.Name,
+		Content:    r.Content,
+		TTL:        r.TTL,
+		ZoneID:     r.ZoneID,
+		CreatedAt:  formatTimestamp(r.CreatedAt),
+		UpdatedAt:  formatTimestamp(r.UpdatedAt),
+	})
+}
+
+// formatTimestamp formats a timestamp in UTC with RFC 3339 (nanoseconds without trailing zeros).
+// The zero time is omitted.
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,48 @@
+package nodion
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZone_MarshalJSON(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	zone := Zone{
+		ID:        "zzz",
+		Name:      "example.com",
+		CreatedAt: time.Date(2024, 1, 2, 4, 5, 6, 0, paris),
+		Records: []Record{
+			{
+				ID:         "id1",
+				RecordType: TypeA,
+				Name:       "www",
+				Content:    "192.0.2.1",
+				TTL:        3600,
+				UpdatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 500_000_000, time.UTC),
+				Tags:       map[string]string{"env": "prod"},
+			},
+		},
+	}
+
+	data, err := json.Marshal(zone)
+	require.NoError(t, err)
+
+	expected := `{"id":"zzz","name":"example.com","created_at":"2024-01-02T03:05:06Z","records":[` +
+		`{"id":"id1","record_type":"a","name":"www","content":"192.0.2.1","ttl":3600,"updated_at":"2024-01-02T03:04:05.5Z"}]}`
+
+	assert.Equal(t, expected, string(data))
+
+	var decoded Zone
+
+	err = json.Unmarshal(data, &decoded)
+	require.NoError(t, err)
+
+	assert.True(t, zone.CreatedAt.Equal(decoded.CreatedAt))
+	assert.True(t, decoded.UpdatedAt.IsZero())
+}
@@ -0,0 +1,67 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RecordMatch is the match criteria of DeleteRecordByMatch.
+type RecordMatch struct {
+	// Name is the name of the records, relative or fully qualified (matched with RelativeName).
+	Name string
+	// RecordType is the type of the records.
+	RecordType RecordType
+	// Content is the content of the records (compared as with Record.EquivalentTo).
+	// An empty content matches all the records with the name and the type.
+	Content string
+}
+
+// DeleteRecordByMatch deletes the records matching the name, the type, and the content (if not empty),
+// without knowing their IDs.
+// It returns the deleted records, no record matching is not an error.
+func (c Client) DeleteRecordByMatch(ctx context.Context, zoneID string, match RecordMatch) ([]Record, error) {
+	if match.Name == "" || match.RecordType == "" {
+		return nil, errors.New("the record match requires a name and a record type")
+	}
+
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, &RecordsFilter{RecordType: match.RecordType})
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []Record
+
+	for _, record := range records {
+		if !match.matches(zone.Name, record) {
+			continue
+		}
+
+		_, err = c.DeleteRecord(ctx, zoneID, record.ID)
+		if err != nil {
+			return deleted, fmt.Errorf("delete record %s (%s) [%s]: %w", record.Name, record.RecordType, record.ID, err)
+		}
+
+		deleted = append(deleted, record)
+	}
+
+	return deleted, nil
+}
+
+func (m RecordMatch) matches(zoneName string, record Record) bool {
+	m.RecordType = m.RecordType.normalized()
+
+	if record.RecordType != m.RecordType || RelativeName(record.Name, zoneName) != RelativeName(m.Name, zoneName) {
+		return false
+	}
+
+	return m.Content == "" || normalizeContent(m.RecordType, record.Content) == normalizeContent(m.RecordType, m.Content)
+}
@@ -0,0 +1,72 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DeleteRecordByMatch(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		match    RecordMatch
+		deleted  []string
+		expected []string
+	}{
+		{
+			desc:     "name, type, and content",
+			match:    RecordMatch{Name: "_acme-challenge", RecordType: TypeTXT, Content: "token1"},
+			deleted:  []string{"_acme-challenge"},
+			expected: []string{"_acme-challenge txt token2", "www a 192.0.2.1"},
+		},
+		{
+			desc:     "fully qualified name",
+			match:    RecordMatch{Name: "www.example.com.", RecordType: TypeA, Content: "192.0.2.1"},
+			deleted:  []string{"www"},
+			expected: []string{"_acme-challenge txt token1", "_acme-challenge txt token2"},
+		},
+		{
+			desc:     "all contents",
+			match:    RecordMatch{Name: "_acme-challenge", RecordType: TypeTXT},
+			deleted:  []string{"_acme-challenge", "_acme-challenge"},
+			expected: []string{"www a 192.0.2.1"},
+		},
+		{
+			desc:     "no match",
+			match:    RecordMatch{Name: "www", RecordType: TypeAAAA},
+			expected: []string{"_acme-challenge txt token1", "_acme-challenge txt token2", "www a 192.0.2.1"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, api := setupFakeAPI(t, []Record{
+				{RecordType: TypeTXT, Name: "_acme-challenge", Content: "token1", TTL: 60},
+				{RecordType: TypeTXT, Name: "_acme-challenge", Content: "token2", TTL: 60},
+				{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+			})
+
+			deleted, err := client.DeleteRecordByMatch(context.Background(), "zzz", test.match)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.deleted, recordNames(deleted))
+			assert.Equal(t, test.expected, api.snapshot())
+		})
+	}
+}
+
+func TestClient_DeleteRecordByMatch_incomplete(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	})
+
+	_, err := client.DeleteRecordByMatch(context.Background(), "zzz", RecordMatch{Name: "www"})
+	require.EqualError(t, err, "the record match requires a name and a record type")
+
+	assert.Equal(t, []string{"www a 192.0.2.1"}, api.snapshot())
+}
@@ -0,0 +1,130 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MultiClient routes the zone operations to the client of the account that owns the zone.
+// The owners of the zones are discovered by listing the zones of each account, and cached.
+type MultiClient struct {
+	clients []*Client
+
+	mu     sync.RWMutex
+	owners map[string]*Client
+}
+
+// NewMultiClient creates a new MultiClient.
+func NewMultiClient(clients ...*Client) *MultiClient {
+	return &MultiClient{
+		clients: clients,
+		owners:  make(map[string]*Client),
+	}
+}
+
+// ClientForZone returns the client of the account that owns a zone.
+func (m *MultiClient) ClientForZone(ctx context.Context, zoneID string) (*Client, error) {
+	m.mu.RLock()
+	client, ok := m.owners[zoneID]
+	m.mu.RUnlock()
+
+	if ok {
+		return client, nil
+	}
+
+	_, err := m.GetZones(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	client, ok = m.owners[zoneID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrZoneNotFound, zoneID)
+	}
+
+	return client, nil
+}
+
+// GetZones lists the zones of all the accounts, and refreshes the owners of the zones.
+func (m *MultiClient) GetZones(ctx context.Context, filter *ZonesFilter) ([]Zone, error) {
+	var all []Zone
+
+	owners := map[string]*Client{}
+
+	for i, client := range m.clients {
+		zones, err := client.GetZones(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("client %d: %w", i, err)
+		}
+
+		for _, zone := range zones {
+			owners[zone.ID] = client
+		}
+
+		all = append(all, zones...)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for zoneID, client := range owners {
+		m.owners[zoneID] = client
+	}
+
+	return all, nil
+}
+
+// DeleteZone deletes an existing DNS Zone with the client of its account.
+func (m *MultiClient) DeleteZone(ctx context.Context, zoneID string) (bool, error) {
+	client, err := m.ClientForZone(ctx, zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := client.DeleteZone(ctx, zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	if deleted {
+		m.mu.Lock()
+		delete(m.owners, zoneID)
+		m.mu.Unlock()
+	}
+
+	return deleted, nil
+}
+
+// GetRecords lists the records of a DNS zone with the client of its account.
+func (m *MultiClient) GetRecords(ctx context.Context, zoneID string, filter *RecordsFilter) ([]Record, error) {
+	client, err := m.ClientForZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetRecords(ctx, zoneID, filter)
+}
+
+// CreateRecord creates a new record for a DNS zone with the client of its account.
+func (m *MultiClient) CreateRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	client, err := m.ClientForZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CreateRecord(ctx, zoneID, record)
+}
+
+// DeleteRecord deletes an existing record of a DNS zone with the client of its account.
+func (m *MultiClient) DeleteRecord(ctx context.Context, zoneID, recordID string) (bool, error) {
+	client, err := m.ClientForZone(ctx, zoneID)
+	if err != nil {
+		return false, err
+	}
+
+	return client.DeleteRecord(ctx, zoneID, recordID)
+}
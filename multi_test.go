@@ -0,0 +1,36 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiClient_GetRecords(t *testing.T) {
+	clientA, muxA := setupTestMux(t)
+	muxA.HandleFunc("/dns_zones", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`{"dns_zones": []}`))
+	})
+
+	clientB, muxB := setupTestMux(t)
+	muxB.HandleFunc("/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	muxB.HandleFunc("/dns_zones/"+sampleZoneID+"/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	multi := NewMultiClient(clientA, clientB)
+
+	client, err := multi.ClientForZone(context.Background(), sampleZoneID)
+	require.NoError(t, err)
+
+	assert.Same(t, clientB, client)
+
+	records, err := multi.GetRecords(context.Background(), sampleZoneID, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, records, 5)
+
+	_, err = multi.ClientForZone(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrZoneNotFound)
+}
@@ -0,0 +1,229 @@
+package nodiontest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette file, without sending any request.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records the interactions to the cassette file.
+	ModeRecord
+)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request.
+// The headers are not recorded, so the credentials are never written to the cassette.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URI    string `json:"uri"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is a http.RoundTripper that records the interactions with the API to a cassette file,
+// and replays them.
+//
+//	rec, err := nodiontest.NewRecorder("testdata/zones.json", nodiontest.ModeReplay, nil)
+//	client.HTTPClient = &http.Client{Transport: rec}
+//	defer rec.Stop()
+type Recorder struct {
+	filename string
+	mode     Mode
+	next     http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+	redactions   []string
+}
+
+// NewRecorder creates a new Recorder.
+// In ModeReplay, the cassette file is loaded, in ModeRecord the requests are sent with next
+// (http.DefaultTransport if nil).
+func NewRecorder(filename string, mode Mode, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	r := &Recorder{filename: filename, mode: mode, next: next}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+
+	err = json.Unmarshal(raw, &r.interactions)
+	if err != nil {
+		return nil, fmt.Errorf("decode cassette %s: %w", filename, err)
+	}
+
+	r.used = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Redact replaces the secrets by "REDACTED" in the recorded bodies and headers.
+func (r *Recorder) Redact(secrets ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, secret := range secrets {
+		if secret != "" {
+			r.redactions = append(r.redactions, secret)
+		}
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = req.Body.Close()
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URI:    req.URL.RequestURI(),
+		Body:   string(body),
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, recorded)
+	}
+
+	return r.replay(req, recorded)
+}
+
+// Stop writes the recorded interactions to the cassette file (ModeRecord only).
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	raw, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.filename, append(raw, '\n'), 0o600)
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+
+	interaction := Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       string(body),
+		},
+	}
+
+	r.interactions = append(r.interactions, r.redact(interaction))
+
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request != r.redact(Interaction{Request: recorded}).Request {
+			continue
+		}
+
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, errors.New("nodiontest: no recorded interaction for " + recorded.Method + " " + recorded.URI)
+}
+
+func (r *Recorder) redact(interaction Interaction) Interaction {
+	for _, secret := range r.redactions {
+		interaction.Request.URI = replace(interaction.Request.URI, secret)
+		interaction.Request.Body = replace(interaction.Request.Body, secret)
+		interaction.Response.Body = replace(interaction.Response.Body, secret)
+
+		for _, values := range interaction.Response.Header {
+			for i, value := range values {
+				values[i] = replace(value, secret)
+			}
+		}
+	}
+
+	return interaction
+}
+
+func replace(s, secret string) string {
+	return strings.ReplaceAll(s, secret, "REDACTED")
+}
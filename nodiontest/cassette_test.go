@@ -0,0 +1,65 @@
+package nodiontest
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"dns_zones":[],"owner":"` + Token + `"}`))
+	})
+
+	filename := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := NewRecorder(filename, ModeRecord, server.Client().Transport)
+	require.NoError(t, err)
+
+	rec.Redact(Token)
+
+	body := doRequest(t, &http.Client{Transport: rec}, server.URL)
+	assert.Equal(t, `{"dns_zones":[],"owner":"secret"}`, body)
+
+	require.NoError(t, rec.Stop())
+
+	server.Close()
+
+	rec, err = NewRecorder(filename, ModeReplay, nil)
+	require.NoError(t, err)
+
+	rec.Redact(Token)
+
+	body = doRequest(t, &http.Client{Transport: rec}, "http://nodion.test")
+	assert.Equal(t, `{"dns_zones":[],"owner":"REDACTED"}`, body)
+
+	_, err = (&http.Client{Transport: rec}).Post("http://nodion.test/dns_zones", "application/json", strings.NewReader("{}"))
+	require.ErrorContains(t, err, "no recorded interaction for POST /dns_zones")
+}
+
+func doRequest(t *testing.T, client *http.Client, baseURL string) string {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/dns_zones?name=example.com", http.NoBody)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+Token)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	defer func() { _ = resp.Body.Close() }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(raw)
+}
@@ -0,0 +1,111 @@
+package nodiontest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Fault is a scripted failure of the Server.
+// The zero value is no failure: the request is handled normally.
+type Fault struct {
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Body is the body of the response.
+	Body string
+	// Delay is the time the server waits before responding,
+	// or before giving up if the request is cancelled first.
+	Delay time.Duration
+	// Header is the headers added to the response.
+	Header http.Header
+}
+
+// FaultStatus returns a Fault that responds with the status code and an API error.
+func FaultStatus(statusCode int) Fault {
+	return Fault{
+		StatusCode: statusCode,
+		Body:       fmt.Sprintf(`{"status":%d,"error":%q}`, statusCode, http.StatusText(statusCode)),
+	}
+}
+
+// FaultRateLimited returns a Fault that responds with 429 Too Many Requests.
+func FaultRateLimited(retryAfter string) Fault {
+	f := FaultStatus(http.StatusTooManyRequests)
+
+	if retryAfter != "" {
+		f.Header = http.Header{"Retry-After": []string{retryAfter}}
+	}
+
+	return f
+}
+
+// FaultTimeout returns a Fault that doesn't respond before the delay,
+// then responds with 504 Gateway Timeout.
+func FaultTimeout(delay time.Duration) Fault {
+	f := FaultStatus(http.StatusGatewayTimeout)
+	f.Delay = delay
+
+	return f
+}
+
+// FaultMalformedJSON returns a Fault that responds with a successful status code and a malformed JSON body.
+func FaultMalformedJSON() Fault {
+	return Fault{StatusCode: http.StatusOK, Body: `{"dns_zones":[{`}
+}
+
+// InjectFaults scripts the responses of the next requests for the method and the path:
+// each request consumes one Fault, once all the faults are consumed the requests are handled normally.
+//
+//	server.InjectFaults(http.MethodGet, "/dns_zones", FaultRateLimited("1"), FaultRateLimited("1"), Fault{}, FaultTimeout(time.Minute))
+func (s *Server) InjectFaults(method, path string, faults ...Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.faults == nil {
+		s.faults = make(map[string][]Fault)
+	}
+
+	key := method + " " + path
+
+	s.faults[key] = append(s.faults[key], faults...)
+}
+
+func (s *Server) nextFault(req *http.Request) (Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := req.Method + " " + req.URL.Path
+
+	faults := s.faults[key]
+	if len(faults) == 0 {
+		return Fault{}, false
+	}
+
+	s.faults[key] = faults[1:]
+
+	return faults[0], faults[0].StatusCode != 0
+}
+
+func (f Fault) serve(rw http.ResponseWriter, req *http.Request) {
+	if f.Delay > 0 {
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-timer.C:
+		}
+	}
+
+	for k, values := range f.Header {
+		for _, v := range values {
+			rw.Header().Add(k, v)
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(f.StatusCode)
+
+	_, _ = rw.Write([]byte(f.Body))
+}
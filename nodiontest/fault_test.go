@@ -0,0 +1,78 @@
+package nodiontest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_InjectFaults(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	server.InjectFaults(http.MethodGet, "/dns_zones",
+		FaultRateLimited("1"),
+		FaultRateLimited(""),
+		Fault{},
+		FaultMalformedJSON(),
+	)
+
+	resp := get(t, server)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+
+	resp = get(t, server)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	resp = get(t, server)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = get(t, server)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	resp = get(t, server)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, server.Requests(), 5)
+}
+
+func TestServer_InjectFaults_timeout(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	server.InjectFaults(http.MethodGet, "/dns_zones", FaultTimeout(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/dns_zones", http.NoBody)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+Token)
+
+	_, err = server.Client().Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	resp := get(t, server)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func get(t *testing.T, server *Server) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/dns_zones", http.NoBody)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+Token)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	return resp
+}
@@ -0,0 +1,139 @@
+// Package nodiontest provides a fake Nodion API server for the tests.
+package nodiontest
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync"
+	"testing"
+)
+
+// Token is the API token expected by the Server.
+const Token = "secret"
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Nodion API server.
+// It checks the API token (see Token), records the received requests, and serves the registered routes.
+type Server struct {
+	*httptest.Server
+
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	requests []Request
+	faults   map[string][]Fault
+}
+
+// NewServer creates and starts a new Server, it's closed at the end of the test.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{mux: http.NewServeMux()}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Handle registers the handler for the given pattern (see http.ServeMux).
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// HandleFunc registers the handler function for the given pattern (see http.ServeMux).
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// HandleFixture registers a route that responds with a fixture file.
+func (s *Server) HandleFixture(method, pattern string, statusCode int, filename string) {
+	s.mux.HandleFunc(pattern, FixtureHandler(method, statusCode, filename))
+}
+
+// Requests returns the requests received by the server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+
+	return requests
+}
+
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	s.mu.Unlock()
+
+	authorization := req.Header.Get("Authorization")
+	if authorization != "Bearer "+Token {
+		http.Error(rw, fmt.Sprintf("invalid API key: %s", authorization), http.StatusUnauthorized)
+		return
+	}
+
+	if fault, ok := s.nextFault(req); ok {
+		fault.serve(rw, req)
+		return
+	}
+
+	s.mux.ServeHTTP(rw, req)
+}
+
+// FixtureHandler returns a handler that responds with a fixture file, if the method matches.
+// The available fixtures are in the `fixtures` directory of this package.
+func FixtureHandler(method string, statusCode int, filename string) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		content, err := Fixture(filename)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rw.WriteHeader(statusCode)
+
+		_, _ = rw.Write(content)
+	}
+}
+
+// Fixture returns the content of a fixture file.
+func Fixture(filename string) ([]byte, error) {
+	return fixtures.ReadFile(path.Join("fixtures", filename))
+}
@@ -0,0 +1,77 @@
+package nodiontest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_HandleFixture(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/dns_zones?name=example.com", http.NoBody)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+Token)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	expected, err := Fixture("get-dns-zones.json")
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, body)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+
+	assert.Equal(t, http.MethodGet, requests[0].Method)
+	assert.Equal(t, "/dns_zones", requests[0].Path)
+	assert.Equal(t, "example.com", requests[0].Query.Get("name"))
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFixture(http.MethodPost, "/dns_zones", http.StatusOK, "create-dns-zone.json")
+
+	resp, err := server.Client().Post(server.URL+"/dns_zones", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+
+	assert.Equal(t, []byte(`{}`), requests[0].Body)
+}
+
+func TestFixtureHandler_method(t *testing.T) {
+	server := NewServer(t)
+	server.HandleFixture(http.MethodPost, "/dns_zones", http.StatusOK, "create-dns-zone.json")
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/dns_zones", http.NoBody)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+Token)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
@@ -0,0 +1,125 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ErrPolicyViolation is returned (wrapped in a PolicyError) when a record mutation is rejected by a RecordValidator.
+var ErrPolicyViolation = errors.New("policy violation")
+
+// RecordValidation is a record mutation submitted to the validators.
+type RecordValidation struct {
+	// Operation is OperationCreateRecord or OperationDeleteRecord.
+	Operation Operation
+	ZoneID    string
+	ZoneName  string
+	// Record is the record to create or to delete.
+	Record Record
+}
+
+// FQDN returns the fully qualified name of the record (without trailing dot).
+func (v RecordValidation) FQDN() string {
+	name := RelativeName(v.Record.Name, v.ZoneName)
+	if name == "@" {
+		return strings.ToLower(strings.TrimSuffix(v.ZoneName, "."))
+	}
+
+	return name + "." + strings.ToLower(strings.TrimSuffix(v.ZoneName, "."))
+}
+
+// RecordValidator validates a record mutation before the request to the API.
+// The mutation is rejected if it returns an error.
+type RecordValidator func(ctx context.Context, v RecordValidation) error
+
+// PolicyError is the error returned when a record mutation is rejected by a RecordValidator.
+type PolicyError struct {
+	Validation RecordValidation
+	Err        error
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("%s: %s %s (%s): %v",
+		ErrPolicyViolation, e.Validation.Operation, e.Validation.FQDN(), e.Validation.Record.RecordType, e.Err)
+}
+
+func (e *PolicyError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is ErrPolicyViolation.
+func (e *PolicyError) Is(target error) bool {
+	return errors.Is(target, ErrPolicyViolation)
+}
+
+// WithRecordValidator adds a validator of the record mutations (creations and deletions).
+// The validators are called in order, before any request related to the mutation.
+func WithRecordValidator(validator RecordValidator) Option {
+	return func(c *Client) error {
+		if validator == nil {
+			return errors.New("validator is required")
+		}
+
+		c.recordValidators = append(c.recordValidators, validator)
+
+		return nil
+	}
+}
+
+// ForbidApexCNAME returns a validator that rejects the creation of CNAME records at the apex of a zone.
+func ForbidApexCNAME() RecordValidator {
+	return func(_ context.Context, v RecordValidation) error {
+		if v.Operation == OperationCreateRecord && v.Record.RecordType == TypeCNAME && RelativeName(v.Record.Name, v.ZoneName) == "@" {
+			return errors.New("CNAME record at the apex")
+		}
+
+		return nil
+	}
+}
+
+// RestrictNames returns a validator that rejects the mutations of the records
+// with a fully qualified name that doesn't match any of the patterns (path.Match syntax, ex: `*.staging.example.com`).
+func RestrictNames(patterns ...string) RecordValidator {
+	return func(_ context.Context, v RecordValidation) error {
+		fqdn := v.FQDN()
+
+		for _, pattern := range patterns {
+			ok, err := path.Match(strings.ToLower(strings.TrimSuffix(pattern, ".")), fqdn)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+
+			if ok {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("the name %s is not allowed", fqdn)
+	}
+}
+
+// validateRecord runs the validators.
+func (c Client) validateRecord(ctx context.Context, operation Operation, zoneID string, record Record) error {
+	if len(c.recordValidators) == 0 {
+		return nil
+	}
+
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+
+	v := RecordValidation{Operation: operation, ZoneID: zoneID, ZoneName: zone.Name, Record: record}
+
+	for _, validator := range c.recordValidators {
+		err := validator(ctx, v)
+		if err != nil {
+			return &PolicyError{Validation: v, Err: err}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRecord_validators(t *testing.T) {
+	client, api := setupFakeAPI(t, nil,
+		WithRecordValidator(ForbidApexCNAME()),
+		WithRecordValidator(RestrictNames("*.staging.example.com", "example.com")),
+	)
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "api.staging", Content: "1.1.1.1", TTL: 60})
+	require.NoError(t, err)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeCNAME, Name: "@", Content: "example.org", TTL: 60})
+	require.ErrorIs(t, err, ErrPolicyViolation)
+	require.EqualError(t, err, "policy violation: create_record example.com (cname): CNAME record at the apex")
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60})
+	require.ErrorIs(t, err, ErrPolicyViolation)
+
+	var policyErr *PolicyError
+	require.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, "www.example.com", policyErr.Validation.FQDN())
+
+	assert.Equal(t, []string{"api.staging a 1.1.1.1"}, api.snapshot())
+}
+
+func TestClient_DeleteRecord_validators(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{ID: "r1", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}, WithRecordValidator(func(_ context.Context, v RecordValidation) error {
+		if v.Operation == OperationDeleteRecord && v.Record.Name == "www" {
+			return errors.New("protected record")
+		}
+
+		return nil
+	}))
+
+	_, err := client.DeleteRecord(context.Background(), "zzz", "r1")
+	require.ErrorIs(t, err, ErrPolicyViolation)
+
+	assert.Len(t, api.snapshot(), 1)
+}
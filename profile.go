@@ -0,0 +1,195 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Errors related to the profiles of the zones.
+var (
+	ErrProfileStoreDisabled = errors.New("profile store is disabled")
+	ErrProfileNotFound      = errors.New("profile not found")
+)
+
+// Profile is a named record set of a zone (e.g. `normal` and `maintenance`), see ActivateProfile.
+type Profile struct {
+	Name    string
+	Records []Record
+}
+
+// ProfileStore stores the profiles of the zones.
+// The Nodion API doesn't support several versions of a zone, so the profiles are managed by the client.
+type ProfileStore interface {
+	// GetProfiles returns the profiles of a zone.
+	GetProfiles(ctx context.Context, zoneID string) ([]Profile, error)
+	// SaveProfile creates or replaces a profile of a zone.
+	SaveProfile(ctx context.Context, zoneID string, profile Profile) error
+	// DeleteProfile removes a profile of a zone.
+	DeleteProfile(ctx context.Context, zoneID, name string) error
+}
+
+// WithProfileStore enables the profiles of the zones (see SaveProfile and ActivateProfile).
+func WithProfileStore(store ProfileStore) Option {
+	return func(c *Client) error {
+		c.profileStore = store
+		return nil
+	}
+}
+
+// SaveProfile creates or replaces a profile of a zone.
+// The records are only stored: the zone is changed by ActivateProfile.
+func (c Client) SaveProfile(ctx context.Context, zoneID, name string, records []Record) error {
+	if c.profileStore == nil {
+		return ErrProfileStoreDisabled
+	}
+
+	if name == "" {
+		return errors.New("the profile name is required")
+	}
+
+	profile := Profile{Name: name}
+	for _, record := range records {
+		profile.Records = append(profile.Records, Record{
+			RecordType: record.RecordType,
+			Name:       record.Name,
+			Content:    record.Content,
+			TTL:        record.TTL,
+		})
+	}
+
+	return c.profileStore.SaveProfile(ctx, zoneID, profile)
+}
+
+// ActivateProfile applies a profile to a zone.
+// The record sets (name and type) of all the profiles of the zone are managed:
+// they are reconciled with the records of the activated profile (see PlanZoneSync),
+// so the record sets only defined by the other profiles are deleted.
+// The other records of the zone are left untouched.
+// The changes are applied with a ChangeSet (the creations before the deletions):
+// if a change fails, the applied changes are rolled back.
+// It returns the applied plan.
+func (c Client) ActivateProfile(ctx context.Context, zoneID, name string) (*Plan, error) {
+	if c.profileStore == nil {
+		return nil, ErrProfileStoreDisabled
+	}
+
+	profiles, err := c.profileStore.GetProfiles(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("get profiles: %w", err)
+	}
+
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var active *Profile
+
+	managed := map[string]struct{}{}
+
+	for i, profile := range profiles {
+		if profile.Name == name {
+			active = &profiles[i]
+		}
+
+		for _, record := range profile.Records {
+			managed[recordSetKey(zone.Name, record)] = struct{}{}
+		}
+	}
+
+	if active == nil {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []Record
+
+	for _, record := range records {
+		if _, ok := managed[recordSetKey(zone.Name, record)]; ok {
+			existing = append(existing, record)
+		}
+	}
+
+	plan := diffRecords(zone, existing, active.Records, SyncOptions{ManageApexNS: true})
+
+	_, err = c.ApplyPlan(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func recordSetKey(zoneName string, record Record) string {
+	return string(record.RecordType) + "\x00" + RelativeName(record.Name, zoneName)
+}
+
+// MemoryProfileStore is an in-memory ProfileStore.
+type MemoryProfileStore struct {
+	mu       sync.RWMutex
+	profiles map[string][]Profile
+}
+
+// NewMemoryProfileStore creates a new MemoryProfileStore.
+func NewMemoryProfileStore() *MemoryProfileStore {
+	return &MemoryProfileStore{profiles: make(map[string][]Profile)}
+}
+
+// GetProfiles returns the profiles of a zone.
+func (m *MemoryProfileStore) GetProfiles(_ context.Context, zoneID string) ([]Profile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	profiles := make([]Profile, 0, len(m.profiles[zoneID]))
+	for _, profile := range m.profiles[zoneID] {
+		profiles = append(profiles, copyProfile(profile))
+	}
+
+	return profiles, nil
+}
+
+// SaveProfile creates or replaces a profile of a zone.
+func (m *MemoryProfileStore) SaveProfile(_ context.Context, zoneID string, profile Profile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, p := range m.profiles[zoneID] {
+		if p.Name == profile.Name {
+			m.profiles[zoneID][i] = copyProfile(profile)
+			return nil
+		}
+	}
+
+	m.profiles[zoneID] = append(m.profiles[zoneID], copyProfile(profile))
+
+	return nil
+}
+
+// DeleteProfile removes a profile of a zone.
+func (m *MemoryProfileStore) DeleteProfile(_ context.Context, zoneID, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var profiles []Profile
+
+	for _, p := range m.profiles[zoneID] {
+		if p.Name != name {
+			profiles = append(profiles, p)
+		}
+	}
+
+	m.profiles[zoneID] = profiles
+
+	return nil
+}
+
+func copyProfile(profile Profile) Profile {
+	profile.Records = append([]Record(nil), profile.Records...)
+	return profile
+}
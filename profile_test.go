@@ -0,0 +1,75 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ActivateProfile(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeNS, Name: "@", Content: "ns1.nodion.com", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+		{RecordType: TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 3600},
+	}, WithProfileStore(NewMemoryProfileStore()))
+
+	ctx := context.Background()
+
+	err := client.SaveProfile(ctx, "zzz", "normal", []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	})
+	require.NoError(t, err)
+
+	err = client.SaveProfile(ctx, "zzz", "maintenance", []Record{
+		{RecordType: TypeA, Name: "www.example.com.", Content: "198.51.100.1", TTL: 60},
+		{RecordType: TypeTXT, Name: "status", Content: "maintenance", TTL: 60},
+	})
+	require.NoError(t, err)
+
+	plan, err := client.ActivateProfile(ctx, "zzz", "maintenance")
+	require.NoError(t, err)
+
+	assert.Len(t, plan.Create, 2)
+	assert.Len(t, plan.Delete, 1)
+
+	expected := []string{
+		"@ ns ns1.nodion.com",
+		"@ mx 10 mail.example.com",
+		"www a 198.51.100.1",
+		"status txt maintenance",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+
+	_, err = client.ActivateProfile(ctx, "zzz", "normal")
+	require.NoError(t, err)
+
+	expected = []string{
+		"@ ns ns1.nodion.com",
+		"@ mx 10 mail.example.com",
+		"www a 192.0.2.1",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+
+	plan, err = client.ActivateProfile(ctx, "zzz", "normal")
+	require.NoError(t, err)
+
+	assert.True(t, plan.Empty())
+}
+
+func TestClient_ActivateProfile_notFound(t *testing.T) {
+	client, _ := setupFakeAPI(t, nil, WithProfileStore(NewMemoryProfileStore()))
+
+	_, err := client.ActivateProfile(context.Background(), "zzz", "maintenance")
+	require.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestClient_ActivateProfile_disabled(t *testing.T) {
+	client, _ := setupFakeAPI(t, nil)
+
+	_, err := client.ActivateProfile(context.Background(), "zzz", "maintenance")
+	require.ErrorIs(t, err, ErrProfileStoreDisabled)
+}
@@ -0,0 +1,65 @@
+package nodion
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit headers.
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+type rateLimitState struct {
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// RateLimit returns the rate limit state sent by the API with the most recent response:
+// the number of requests allowed in the current window, the number of remaining requests, and the end of the window.
+// It returns zero values until a response with rate limit headers is received.
+func (c Client) RateLimit() (limit, remaining int, reset time.Time) {
+	c.rateLimit.mu.Lock()
+	defer c.rateLimit.mu.Unlock()
+
+	return c.rateLimit.limit, c.rateLimit.remaining, c.rateLimit.reset
+}
+
+// update updates the state from the headers of a response, if they are present.
+// The reset header is either a Unix timestamp or a number of seconds.
+func (r *rateLimitState) update(header http.Header, clock Clock) {
+	limit, err := strconv.Atoi(header.Get(headerRateLimitLimit))
+	if err != nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header.Get(headerRateLimitRemaining))
+	if err != nil {
+		return
+	}
+
+	var reset time.Time
+
+	if value, err := strconv.ParseInt(header.Get(headerRateLimitReset), 10, 64); err == nil {
+		now := clock.Now()
+
+		if value > now.Unix()/2 {
+			reset = time.Unix(value, 0)
+		} else {
+			reset = now.Add(time.Duration(value) * time.Second)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.limit = limit
+	r.remaining = remaining
+	r.reset = reset
+}
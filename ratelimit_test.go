@@ -0,0 +1,56 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	client, mux := setupTestMux(t, WithClock(&fixedClock{now: now}))
+
+	limit, remaining, reset := client.RateLimit()
+	assert.Zero(t, limit)
+	assert.Zero(t, remaining)
+	assert.True(t, reset.IsZero())
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-RateLimit-Limit", "100")
+		rw.Header().Set("X-RateLimit-Remaining", "42")
+		rw.Header().Set("X-RateLimit-Reset", "30")
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	})
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	limit, remaining, reset = client.RateLimit()
+	assert.Equal(t, 100, limit)
+	assert.Equal(t, 42, remaining)
+	assert.Equal(t, now.Add(30*time.Second), reset)
+}
+
+func TestClient_RateLimit_error(t *testing.T) {
+	client := setupTest(t, "/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-RateLimit-Limit", "100")
+		rw.Header().Set("X-RateLimit-Remaining", "0")
+		rw.Header().Set("X-RateLimit-Reset", "1672570800")
+
+		http.Error(rw, `{"status": 429, "error": "Too Many Requests"}`, http.StatusTooManyRequests)
+	})
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+
+	limit, remaining, reset := client.RateLimit()
+	assert.Equal(t, 100, limit)
+	assert.Equal(t, 0, remaining)
+	assert.Equal(t, time.Unix(1672570800, 0), reset)
+}
@@ -0,0 +1,27 @@
+package nodion
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrReadOnly is returned by the mutations of a read-only client (see WithReadOnly).
+var ErrReadOnly = errors.New("read-only client")
+
+// WithReadOnly makes the client read-only:
+// the mutations (and the requests sent with Do other than GET and HEAD) return ErrReadOnly without calling the API.
+func WithReadOnly() Option {
+	return func(c *Client) error {
+		c.readOnly = true
+		return nil
+	}
+}
+
+// checkMethod refuses the mutating requests of a read-only client.
+func (c Client) checkMethod(method string) error {
+	if c.readOnly && method != http.MethodGet && method != http.MethodHead {
+		return ErrReadOnly
+	}
+
+	return nil
+}
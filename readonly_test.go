@@ -0,0 +1,40 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithReadOnly(t *testing.T) {
+	client, mux := setupTestMux(t, WithReadOnly(), WithMutationHook(func(context.Context, Mutation) {}))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.CreateZone(context.Background(), "example.com")
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	_, err = client.DeleteZone(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	_, err = client.CreateRecord(context.Background(), "xxx", Record{RecordType: TypeA, Name: "www", Content: "192.0.2.1"})
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	_, err = client.DeleteRecord(context.Background(), "xxx", "yyy")
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, mux.URL+"/dns_zones/xxx", http.NoBody)
+	require.NoError(t, err)
+
+	err = client.Do(req, nil)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	// only the listing of the zones has reached the API.
+	assert.Len(t, mux.Requests(), 1)
+}
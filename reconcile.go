@@ -0,0 +1,95 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WithCreateRecordRetry enables the retries of the record creations (see WithRetry), with a reconciliation:
+// when a creation fails with a transient error, the request may have been applied by the API before the failure
+// (e.g. a timeout after the commit), so the records of the zone are queried before retrying,
+// and a matching record (same name, type, and content) created since the first attempt is returned instead.
+// It prevents the duplicated records (e.g. TXT records of ACME challenges).
+// It requires an additional call to the API before the first attempt, and after each failure.
+// It must be used after WithRetry.
+func WithCreateRecordRetry() Option {
+	return func(c *Client) error {
+		if c.retrier == nil {
+			return errors.New("the retries of the record creations require WithRetry")
+		}
+
+		c.retrier.reconcileCreates = true
+
+		return nil
+	}
+}
+
+// postRecordReconciled creates a record with retries and reconciliation (see WithCreateRecordRetry).
+func (c Client) postRecordReconciled(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	existing, err := c.findMatchingRecords(ctx, zoneID, record)
+	if err != nil {
+		return nil, fmt.Errorf("reconciliation: %w", err)
+	}
+
+	known := map[string]struct{}{}
+	for _, r := range existing {
+		known[r.ID] = struct{}{}
+	}
+
+	for attempt := 0; ; attempt++ {
+		newRecord, err := c.postRecord(ctx, zoneID, record)
+		if err == nil || !isRetryableError(ctx, err) {
+			return newRecord, err
+		}
+
+		matches, errR := c.findMatchingRecords(ctx, zoneID, record)
+		if errR != nil {
+			return nil, fmt.Errorf("%w (reconciliation: %v)", err, errR)
+		}
+
+		for _, match := range matches {
+			if _, ok := known[match.ID]; !ok {
+				// the failed attempt has been applied.
+				return &match, nil
+			}
+		}
+
+		delay, ok := c.retrier.next(attempt, err)
+		if !ok {
+			return nil, err
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+
+		case <-timer.C:
+		}
+	}
+}
+
+// findMatchingRecords returns the records of a zone with the name, the type, and the content of a record.
+// The cache is bypassed: the zone may have been modified by a failed request.
+func (c Client) findMatchingRecords(ctx context.Context, zoneID string, record Record) ([]Record, error) {
+	c.cache.invalidate(zoneID)
+
+	records, err := c.GetRecords(ctx, zoneID, &RecordsFilter{Name: record.Name, RecordType: record.RecordType, Content: record.Content})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Record
+
+	for _, r := range records {
+		if r.EquivalentTo(record, EquivalenceOptions{IgnoreTTL: true}) {
+			result = append(result, r)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupCommitThenFail serves a fake API where the first creation of a record is applied, but answered with a 502.
+func setupCommitThenFail(t *testing.T, opts ...Option) (*Client, *fakeAPI, *atomic.Int32) {
+	t.Helper()
+
+	client, mux := setupTestMux(t, opts...)
+
+	api := &fakeAPI{records: map[string]Record{}}
+
+	var posts atomic.Int32
+
+	mux.HandleFunc("/dns_zones/zzz/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost && posts.Add(1) == 1 {
+			api.ServeHTTP(httptest.NewRecorder(), req)
+			http.Error(rw, `{"status": 502, "error": "Bad Gateway"}`, http.StatusBadGateway)
+
+			return
+		}
+
+		api.ServeHTTP(rw, req)
+	})
+
+	return client, api, &posts
+}
+
+func TestWithCreateRecordRetry(t *testing.T) {
+	client, api, posts := setupCommitThenFail(t, WithRetry(2, time.Millisecond), WithCreateRecordRetry())
+
+	record := Record{RecordType: TypeTXT, Name: "_acme-challenge", Content: "token", TTL: 60}
+
+	newRecord, err := client.CreateRecord(context.Background(), "zzz", record)
+	require.NoError(t, err)
+
+	assert.Equal(t, "id1", newRecord.ID)
+	assert.EqualValues(t, 1, posts.Load())
+	assert.Equal(t, []string{"_acme-challenge txt token"}, api.snapshot())
+}
+
+func TestWithCreateRecordRetry_existing(t *testing.T) {
+	client, api, posts := setupCommitThenFail(t, WithRetry(2, time.Millisecond), WithCreateRecordRetry())
+
+	api.records["old"] = Record{ID: "old", RecordType: TypeTXT, Name: "_acme-challenge", Content: "token", TTL: 60}
+
+	// make the first attempt fail without being applied.
+	posts.Store(1)
+
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPost && posts.Add(1) == 2 {
+			return nil, context.DeadlineExceeded
+		}
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	record := Record{RecordType: TypeTXT, Name: "_acme-challenge", Content: "token", TTL: 60}
+
+	newRecord, err := client.CreateRecord(context.Background(), "zzz", record)
+	require.NoError(t, err)
+
+	// the record existing before the creation is not adopted.
+	assert.NotEqual(t, "old", newRecord.ID)
+	assert.Len(t, api.snapshot(), 2)
+}
+
+func TestClient_CreateRecord_noReconciliation(t *testing.T) {
+	client, api, _ := setupCommitThenFail(t, WithRetry(2, time.Millisecond))
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeTXT, Name: "_acme-challenge", Content: "token", TTL: 60})
+	require.Error(t, err)
+
+	assert.Len(t, api.snapshot(), 1)
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,120 @@
+package nodion
+
+import (
+	"context"
+)
+
+// GetRecordSet gets the records with the same name and type (the name is matched with RelativeName).
+func (c Client) GetRecordSet(ctx context.Context, zoneID, name string, recordType RecordType) ([]Record, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getRecordSet(ctx, zone, name, recordType)
+}
+
+// SetRecordSet reconciles the records with the same name and type to exactly the desired values:
+// the missing values are created, the records with other values (or another TTL) are deleted.
+// A zero TTL keeps the TTL of the existing records (the new records get the TTL of the existing records, if any).
+// The changes are applied with a ChangeSet (the creations before the deletions).
+// It returns the resulting records.
+func (c Client) SetRecordSet(ctx context.Context, zoneID, name string, recordType RecordType, values []string, ttl int) ([]Record, error) {
+	ctx, unlock := c.lockZone(ctx, zoneID)
+	defer unlock()
+
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.getRecordSet(ctx, zone, name, recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl == 0 && len(existing) > 0 {
+		ttl = existing[0].TTL
+	}
+
+	kept := map[string]Record{}
+
+	var toDelete []Record
+
+	for _, record := range existing {
+		_, duplicate := kept[record.Content]
+
+		if duplicate || record.TTL != ttl || !contains(values, record.Content) {
+			toDelete = append(toDelete, record)
+			continue
+		}
+
+		kept[record.Content] = record
+	}
+
+	changeSet := c.BeginChangeSet(zoneID)
+
+	var result []Record
+
+	seen := map[string]struct{}{}
+
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+
+		if record, ok := kept[value]; ok {
+			result = append(result, record)
+			continue
+		}
+
+		changeSet.Add(Record{
+			RecordType: recordType,
+			Name:       RelativeName(name, zone.Name),
+			Content:    value,
+			TTL:        ttl,
+		})
+	}
+
+	for _, record := range toDelete {
+		changeSet.Delete(record)
+	}
+
+	created, err := changeSet.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, created...), nil
+}
+
+func (c Client) getRecordSet(ctx context.Context, zone *Zone, name string, recordType RecordType) ([]Record, error) {
+	records, err := c.GetRecords(ctx, zone.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	name = RelativeName(name, zone.Name)
+
+	var set []Record
+
+	for _, record := range records {
+		if record.RecordType == recordType.normalized() && RelativeName(record.Name, zone.Name) == name {
+			set = append(set, record)
+		}
+	}
+
+	return set, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetRecordSet(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		{RecordType: TypeA, Name: "www.example.com", Content: "2.2.2.2", TTL: 60},
+		{RecordType: TypeA, Name: "www", Content: "2.2.2.2", TTL: 60},
+		{RecordType: TypeA, Name: "www", Content: "4.4.4.4", TTL: 3600},
+		{RecordType: TypeAAAA, Name: "www", Content: "::1", TTL: 60},
+	})
+
+	records, err := client.SetRecordSet(context.Background(), "zzz", "www", TypeA, []string{"2.2.2.2", "3.3.3.3", "4.4.4.4", "3.3.3.3"}, 60)
+	require.NoError(t, err)
+
+	require.Len(t, records, 3)
+
+	expected := []string{
+		"www.example.com a 2.2.2.2",
+		"www aaaa ::1",
+		"www a 3.3.3.3",
+		"www a 4.4.4.4",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+
+	set, err := client.GetRecordSet(context.Background(), "zzz", "www.example.com.", TypeA)
+	require.NoError(t, err)
+
+	assert.Len(t, set, 3)
+}
+
+func TestClient_SetRecordSet_keepTTL(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "2.2.2.2", TTL: 3600},
+	})
+
+	records, err := client.SetRecordSet(context.Background(), "zzz", "www", TypeA, []string{"1.1.1.1", "2.2.2.2"}, 0)
+	require.NoError(t, err)
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "id1", records[0].ID)
+	assert.Equal(t, "id2", records[1].ID)
+
+	records, err = client.SetRecordSet(context.Background(), "zzz", "www", TypeA, []string{"1.1.1.1", "3.3.3.3"}, 0)
+	require.NoError(t, err)
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "id1", records[0].ID)
+	assert.Equal(t, 3600, records[1].TTL)
+
+	assert.Equal(t, []string{"www a 1.1.1.1", "www a 3.3.3.3"}, api.snapshot())
+}
@@ -0,0 +1,84 @@
+package nodion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RecordType is the type of a DNS record.
+// The API requires the types in lowercase: the types are normalized when they are parsed, encoded, and decoded.
+type RecordType string
+
+// Record types.
+const (
+	TypeA     RecordType = "a"
+	TypeAAAA  RecordType = "aaaa"
+	TypeNS    RecordType = "ns"
+	TypeALIAS RecordType = "alias"
+	TypeCNAME RecordType = "cname"
+	TypeMX    RecordType = "mx"
+	TypeTXT   RecordType = "txt"
+	TypePTR   RecordType = "ptr"
+	TypeSRV   RecordType = "srv"
+)
+
+// RecordTypes are the record types supported by the API.
+var RecordTypes = []RecordType{TypeA, TypeAAAA, TypeNS, TypeALIAS, TypeCNAME, TypeMX, TypeTXT, TypePTR, TypeSRV}
+
+// ParseRecordType parses a record type (case-insensitive).
+func ParseRecordType(s string) (RecordType, error) {
+	t := RecordType(strings.ToLower(strings.TrimSpace(s)))
+	if !t.Valid() {
+		return "", fmt.Errorf("unsupported record type: %s", s)
+	}
+
+	return t, nil
+}
+
+// Valid reports whether the type is supported by the API.
+func (t RecordType) Valid() bool {
+	for _, recordType := range RecordTypes {
+		if t == recordType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the type in lowercase, as used by the API.
+func (t RecordType) String() string {
+	return strings.ToLower(string(t))
+}
+
+// normalized returns the type in lowercase, as compared by the client.
+func (t RecordType) normalized() RecordType {
+	return RecordType(strings.ToLower(string(t)))
+}
+
+// MarshalJSON encodes the type in lowercase, as required by the API.
+func (t RecordType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToLower(string(t)))
+}
+
+// UnmarshalJSON decodes a type (case-insensitive).
+func (t *RecordType) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	*t = RecordType(strings.ToLower(s))
+
+	return nil
+}
+
+// EncodeValues encodes the type in lowercase in the query strings (see github.com/google/go-querystring).
+func (t RecordType) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, strings.ToLower(string(t)))
+	return nil
+}
@@ -0,0 +1,86 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecordType(t *testing.T) {
+	recordType, err := ParseRecordType("AAAA")
+	require.NoError(t, err)
+
+	assert.Equal(t, TypeAAAA, recordType)
+	assert.Equal(t, "aaaa", recordType.String())
+
+	_, err = ParseRecordType("CAA")
+	require.EqualError(t, err, "unsupported record type: CAA")
+}
+
+func TestRecordType_Valid(t *testing.T) {
+	assert.True(t, TypeSRV.Valid())
+	assert.False(t, RecordType("A").Valid())
+	assert.False(t, RecordType("").Valid())
+}
+
+func TestRecordType_json(t *testing.T) {
+	data, err := json.Marshal(Record{RecordType: "CNAME"})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"record_type":"cname"}`, string(data))
+
+	var record Record
+
+	err = json.Unmarshal([]byte(`{"record_type":"MX"}`), &record)
+	require.NoError(t, err)
+
+	assert.Equal(t, TypeMX, record.RecordType)
+}
+
+func TestRecordType_EncodeValues(t *testing.T) {
+	values, err := query.Values(RecordsFilter{RecordType: "TXT"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "txt", values.Get("record_type"))
+}
+
+func TestClient_CreateRecord_upperCaseType(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}, WithCNAMEConflictCheck(), WithRecordValidator(ForbidApexCNAME()))
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: "CNAME", Name: "@", Content: "example.org", TTL: 60})
+	require.ErrorIs(t, err, ErrPolicyViolation)
+
+	_, err = client.CreateRecord(context.Background(), "zzz", Record{RecordType: "CNAME", Name: "www", Content: "example.org", TTL: 60})
+	require.ErrorIs(t, err, ErrRecordConflict)
+
+	value := strings.Repeat("a", 300)
+
+	record, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: "TXT", Name: "long", Content: value, TTL: 60})
+	require.NoError(t, err)
+
+	assert.Equal(t, TypeTXT, record.RecordType)
+	assert.Equal(t, value, record.Content)
+
+	stored := api.sorted()
+	require.Len(t, stored, 2)
+	assert.Equal(t, TypeTXT, stored[1].RecordType)
+	assert.Contains(t, stored[1].Content, `" "`)
+
+	records, err := client.GetRecords(context.Background(), "zzz", &RecordsFilter{RecordType: "TXT", Content: value})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, value, records[0].Content)
+
+	exists, err := client.RecordExists(context.Background(), "zzz", "www", "A")
+	require.NoError(t, err)
+
+	assert.True(t, exists)
+}
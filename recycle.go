@@ -0,0 +1,95 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Errors related to the recycle bin.
+var (
+	ErrRecycleBinDisabled = errors.New("recycle bin is disabled")
+	ErrNothingToUndo      = errors.New("no deleted record to restore")
+)
+
+// WithRecycleBin keeps a snapshot of the records deleted through the client (for the lifetime of the process),
+// allowing to restore them with UndoLastDelete.
+// Each deletion requires an additional call to the API to fetch the record before its deletion.
+func WithRecycleBin() Option {
+	return func(c *Client) error {
+		c.recycleBin = &recycleBin{records: make(map[string][]Record)}
+		return nil
+	}
+}
+
+// UndoLastDelete restores the last record deleted, through the client, from a zone.
+// The restored record has a new ID.
+func (c Client) UndoLastDelete(ctx context.Context, zoneID string) (*Record, error) {
+	if c.recycleBin == nil {
+		return nil, ErrRecycleBinDisabled
+	}
+
+	record, ok := c.recycleBin.pop(zoneID)
+	if !ok {
+		return nil, ErrNothingToUndo
+	}
+
+	newRecord, err := c.CreateRecord(ctx, zoneID, Record{
+		RecordType: record.RecordType,
+		Name:       record.Name,
+		Content:    record.Content,
+		TTL:        record.TTL,
+		Tags:       record.Tags,
+	})
+	if err != nil {
+		c.recycleBin.push(zoneID, record)
+
+		return nil, fmt.Errorf("restore record %s (%s): %w", record.Name, record.RecordType, err)
+	}
+
+	return newRecord, nil
+}
+
+// findRecord gets a record of a zone by its ID.
+func (c Client) findRecord(ctx context.Context, zoneID, recordID string) (*Record, error) {
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.ID == recordID {
+			return &record, nil
+		}
+	}
+
+	return nil, fmt.Errorf("record %s not found in the zone %s", recordID, zoneID)
+}
+
+type recycleBin struct {
+	mu      sync.Mutex
+	records map[string][]Record
+}
+
+func (r *recycleBin) push(zoneID string, record Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records[zoneID] = append(r.records[zoneID], record)
+}
+
+func (r *recycleBin) pop(zoneID string) (Record, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := r.records[zoneID]
+	if len(records) == 0 {
+		return Record{}, false
+	}
+
+	record := records[len(records)-1]
+	r.records[zoneID] = records[:len(records)-1]
+
+	return record, true
+}
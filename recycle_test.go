@@ -0,0 +1,53 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UndoLastDelete(t *testing.T) {
+	client, mux := setupTestMux(t, WithRecycleBin())
+
+	var restored Record
+
+	mux.HandleFunc("/dns_zones/xxx/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")(rw, req)
+			return
+		}
+
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&restored))
+
+		readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")(rw, req)
+	})
+	mux.HandleFunc("/dns_zones/xxx/records/843fa60c-dc30-47c4-a818-fee31118a43f", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	_, err := client.UndoLastDelete(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrNothingToUndo)
+
+	deleted, err := client.DeleteRecord(context.Background(), "xxx", "843fa60c-dc30-47c4-a818-fee31118a43f")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	_, err = client.UndoLastDelete(context.Background(), "xxx")
+	require.NoError(t, err)
+
+	expected := Record{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 3600}
+	assert.Equal(t, expected, restored)
+
+	_, err = client.UndoLastDelete(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrNothingToUndo)
+}
+
+func TestClient_UndoLastDelete_disabled(t *testing.T) {
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	_, err = client.UndoLastDelete(context.Background(), "xxx")
+	require.ErrorIs(t, err, ErrRecycleBinDisabled)
+}
@@ -0,0 +1,196 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotOwner is returned when the ownership registry refuses to modify a record owned by someone else.
+var ErrNotOwner = errors.New("record not owned by this client")
+
+const ownershipPrefix = "_owner"
+
+// WithOwnershipRegistry enables the ownership registry (external-dns style).
+// The client writes a companion TXT record (`_owner.<type>.<name>`) identifying the owner of each set of records (same name and type),
+// and refuses to create or delete records in a set owned by someone else, or in a set of records not managed by a registry.
+func WithOwnershipRegistry(ownerID string) Option {
+	return func(c *Client) error {
+		if ownerID == "" {
+			return errors.New("owner ID is required")
+		}
+
+		c.registry = &ownershipRegistry{ownerID: ownerID}
+
+		return nil
+	}
+}
+
+type ownershipRegistry struct {
+	ownerID string
+}
+
+func (r *ownershipRegistry) content() string {
+	return fmt.Sprintf("heritage=nodion,nodion/owner=%s", r.ownerID)
+}
+
+// ownershipRecordName returns the name of the companion TXT record of a set of records.
+func ownershipRecordName(name string, recordType RecordType) string {
+	labels := []string{ownershipPrefix, strings.ToLower(string(recordType))}
+
+	if name != "@" && name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if label == "*" {
+				label = "_wildcard"
+			}
+
+			labels = append(labels, label)
+		}
+	}
+
+	return strings.Join(labels, ".")
+}
+
+func isOwnershipRecord(record Record) bool {
+	return record.RecordType == TypeTXT && (record.Name == ownershipPrefix || strings.HasPrefix(record.Name, ownershipPrefix+"."))
+}
+
+func (c Client) createOwnedRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
+	name, err := c.ownershipName(ctx, zoneID, record.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := c.checkOwnership(ctx, zoneID, name, record.RecordType)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecord, err := c.createRecord(ctx, zoneID, record)
+	if err != nil {
+		return nil, err
+	}
+
+	if owned {
+		return newRecord, nil
+	}
+
+	_, err = c.createRecord(ctx, zoneID, Record{
+		RecordType: TypeTXT,
+		Name:       ownershipRecordName(name, record.RecordType),
+		Content:    c.registry.content(),
+		TTL:        record.TTL,
+	})
+	if err != nil {
+		// the new record would not be managed by the registry.
+		_, errD := c.deleteRecord(ctx, zoneID, newRecord.ID, newRecord)
+		if errD != nil {
+			return nil, fmt.Errorf("create ownership record: %w (delete record %s: %w)", err, newRecord.ID, errD)
+		}
+
+		return nil, fmt.Errorf("create ownership record: %w", err)
+	}
+
+	return newRecord, nil
+}
+
+func (c Client) deleteOwnedRecord(ctx context.Context, zoneID string, record Record) (bool, error) {
+	name, err := c.ownershipName(ctx, zoneID, record.Name)
+	if err != nil {
+		return false, err
+	}
+
+	owned, err := c.checkOwnership(ctx, zoneID, name, record.RecordType)
+	if err != nil {
+		return false, err
+	}
+
+	if !owned {
+		return false, fmt.Errorf("%w: %s (%s) is not managed by a registry", ErrNotOwner, name, record.RecordType)
+	}
+
+	deleted, err := c.deleteRecord(ctx, zoneID, record.ID, &record)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+
+	// removes the companion record when the set of records is empty.
+	remaining, err := c.GetRecords(ctx, zoneID, &RecordsFilter{Name: name, RecordType: record.RecordType})
+	if err != nil {
+		return true, fmt.Errorf("get remaining records: %w", err)
+	}
+
+	if len(remaining) > 0 {
+		return true, nil
+	}
+
+	companions, err := c.getOwnershipRecords(ctx, zoneID, name, record.RecordType)
+	if err != nil {
+		return true, err
+	}
+
+	for _, companion := range companions {
+		companion := companion
+
+		_, err = c.deleteRecord(ctx, zoneID, companion.ID, &companion)
+		if err != nil {
+			return true, fmt.Errorf("delete ownership record: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// ownershipName returns the name of a set of records relative to the zone,
+// so "", "@", and the FQDN of the apex (or of any other name) resolve to the same owner.
+func (c Client) ownershipName(ctx context.Context, zoneID, name string) (string, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return "", err
+	}
+
+	return RelativeName(name, zone.Name), nil
+}
+
+// checkOwnership returns true if the set of records is owned by the client,
+// and false if the set of records is empty and has no owner.
+func (c Client) checkOwnership(ctx context.Context, zoneID, name string, recordType RecordType) (bool, error) {
+	companions, err := c.getOwnershipRecords(ctx, zoneID, name, recordType)
+	if err != nil {
+		return false, err
+	}
+
+	for _, companion := range companions {
+		if companion.Content != c.registry.content() {
+			return false, fmt.Errorf("%w: %s (%s) is owned by %q", ErrNotOwner, name, recordType, companion.Content)
+		}
+	}
+
+	if len(companions) > 0 {
+		return true, nil
+	}
+
+	existing, err := c.GetRecords(ctx, zoneID, &RecordsFilter{Name: name, RecordType: recordType})
+	if err != nil {
+		return false, fmt.Errorf("get existing records: %w", err)
+	}
+
+	if len(existing) > 0 {
+		return false, fmt.Errorf("%w: %s (%s) is not managed by a registry", ErrNotOwner, name, recordType)
+	}
+
+	return false, nil
+}
+
+func (c Client) getOwnershipRecords(ctx context.Context, zoneID, name string, recordType RecordType) ([]Record, error) {
+	records, err := c.GetRecords(ctx, zoneID, &RecordsFilter{
+		Name:       ownershipRecordName(name, recordType),
+		RecordType: TypeTXT,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ownership records: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,92 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ownershipRegistry(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "@", Content: "1.2.3.4", TTL: 3600},
+		{RecordType: TypeA, Name: "other", Content: "1.2.3.4", TTL: 3600},
+		{RecordType: TypeTXT, Name: "_owner.a.other", Content: "heritage=nodion,nodion/owner=someone", TTL: 3600},
+	}, WithOwnershipRegistry("me"))
+
+	ctx := context.Background()
+
+	// unmanaged set.
+	_, err := client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "@", Content: "5.6.7.8", TTL: 60})
+	require.ErrorIs(t, err, ErrNotOwner)
+
+	// owned by someone else.
+	_, err = client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "other", Content: "5.6.7.8", TTL: 60})
+	require.ErrorIs(t, err, ErrNotOwner)
+
+	_, err = client.DeleteRecord(ctx, "zzz", "id2")
+	require.ErrorIs(t, err, ErrNotOwner)
+
+	record, err := client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: "*.www", Content: "5.6.7.8", TTL: 60})
+	require.NoError(t, err)
+
+	assert.Contains(t, api.snapshot(), "_owner.a._wildcard.www txt heritage=nodion,nodion/owner=me")
+
+	_, err = client.DeleteRecord(ctx, "zzz", record.ID)
+	require.NoError(t, err)
+
+	expected := []string{
+		"@ a 1.2.3.4",
+		"other a 1.2.3.4",
+		"_owner.a.other txt heritage=nodion,nodion/owner=someone",
+	}
+
+	assert.Equal(t, expected, api.snapshot())
+}
+
+func TestClient_ownershipRegistry_ownershipRecordError(t *testing.T) {
+	client, api := setupFakeAPI(t, nil, WithOwnershipRegistry("me"))
+
+	var posts atomic.Int32
+
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		// the second creation is the ownership record.
+		if req.Method == http.MethodPost && posts.Add(1) == 2 {
+			return nil, errors.New("connection reset")
+		}
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "5.6.7.8", TTL: 60})
+	require.ErrorContains(t, err, "create ownership record")
+
+	assert.Empty(t, api.snapshot())
+}
+
+func TestClient_ownershipRegistry_apexNames(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "@", Content: "1.2.3.4", TTL: 3600},
+		{RecordType: TypeTXT, Name: "_owner.a", Content: "heritage=nodion,nodion/owner=me", TTL: 3600},
+		{RecordType: TypeAAAA, Name: "@", Content: "2001:db8::1", TTL: 3600},
+		{RecordType: TypeTXT, Name: "_owner.aaaa", Content: "heritage=nodion,nodion/owner=someone", TTL: 3600},
+	}, WithOwnershipRegistry("me"))
+
+	ctx := context.Background()
+
+	for _, name := range []string{"", "example.com", "Example.com."} {
+		_, err := client.CreateRecord(ctx, "zzz", Record{RecordType: TypeA, Name: name, Content: "5.6.7.8", TTL: 60})
+		require.NoError(t, err, name)
+
+		_, err = client.CreateRecord(ctx, "zzz", Record{RecordType: TypeAAAA, Name: name, Content: "2001:db8::2", TTL: 60})
+		require.ErrorIs(t, err, ErrNotOwner, name)
+	}
+
+	// no other ownership record.
+	assert.Len(t, api.snapshot(), 7)
+	assert.NotContains(t, api.snapshot(), "_owner.a.example.com txt heritage=nodion,nodion/owner=me")
+}
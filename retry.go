@@ -0,0 +1,195 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WithRetry enables the retries of the GET requests that failed because of a network error,
+// a 429 Too Many Requests response, or a 5xx response.
+// The delay before the n-th retry is baseDelay * 2^(n-1) (see WithBackoff).
+// The mutations are never retried: their effect is unknown when the response is lost.
+func WithRetry(maxRetries int, baseDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxRetries <= 0 {
+			return errors.New("max retries must be positive")
+		}
+
+		if baseDelay <= 0 {
+			return errors.New("retry base delay must be positive")
+		}
+
+		c.retrier = &retrier{maxRetries: maxRetries, backoff: ExponentialBackoff(baseDelay, 0)}
+
+		return nil
+	}
+}
+
+// WithRetryBudget limits the retries with a budget shared by all the calls of the client
+// (like the retry throttling of gRPC), so the retries don't multiply the load during an outage:
+// each failure removes one token from the budget, each success adds tokenRatio tokens (up to maxTokens),
+// and the retries are allowed only while the budget is above half of maxTokens.
+// It must be used after WithRetry.
+func WithRetryBudget(maxTokens int, tokenRatio float64) Option {
+	return func(c *Client) error {
+		if c.retrier == nil {
+			return errors.New("the retry budget requires WithRetry")
+		}
+
+		if maxTokens <= 0 || tokenRatio <= 0 {
+			return errors.New("the max tokens and the token ratio of the retry budget must be positive")
+		}
+
+		c.retrier.budget = &retryBudget{
+			maxTokens:  float64(maxTokens),
+			tokens:     float64(maxTokens),
+			tokenRatio: tokenRatio,
+		}
+
+		return nil
+	}
+}
+
+// RetryStats are the counters of the retries of a client.
+type RetryStats struct {
+	// Attempts is the number of requests sent, including the retries.
+	Attempts int64
+	// Retries is the number of retries.
+	Retries int64
+	// Throttled is the number of retries denied by the retry budget.
+	Throttled int64
+}
+
+// RetryStats returns the counters of the retries (zero if the retries are not enabled).
+func (c Client) RetryStats() RetryStats {
+	if c.retrier == nil {
+		return RetryStats{}
+	}
+
+	return RetryStats{
+		Attempts:  c.retrier.attempts.Load(),
+		Retries:   c.retrier.retries.Load(),
+		Throttled: c.retrier.throttled.Load(),
+	}
+}
+
+type retrier struct {
+	maxRetries int
+	backoff    Backoff
+	budget     *retryBudget
+
+	reconcileCreates bool
+
+	attempts  atomic.Int64
+	retries   atomic.Int64
+	throttled atomic.Int64
+}
+
+// retry reports whether a failed request must be retried, and the delay before the retry.
+// It must be called after each attempt (err is nil for a success).
+func (r *retrier) retry(req *http.Request, attempt int, err error) (time.Duration, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	r.attempts.Add(1)
+
+	if err == nil {
+		r.budget.success()
+		return 0, false
+	}
+
+	if !isRetryable(req, err) {
+		return 0, false
+	}
+
+	return r.next(attempt, err)
+}
+
+// next reports whether a retryable failure can be retried (max retries and budget), and the delay before the retry.
+func (r *retrier) next(attempt int, err error) (time.Duration, bool) {
+	allowed := r.budget.failure()
+
+	if attempt >= r.maxRetries {
+		return 0, false
+	}
+
+	if !allowed {
+		r.throttled.Add(1)
+		return 0, false
+	}
+
+	r.retries.Add(1)
+
+	var resp *http.Response
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		resp = apiErr.response
+	}
+
+	return r.backoff.NextDelay(attempt, err, resp), true
+}
+
+func isRetryable(req *http.Request, err error) bool {
+	return req.Method == http.MethodGet && isRetryableError(req.Context(), err)
+}
+
+// isRetryableError reports whether an error is transient: network error, 429 or 5xx response.
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// retryBudget is a token bucket shared by the calls of a client.
+// A nil retryBudget is an unlimited budget.
+type retryBudget struct {
+	maxTokens  float64
+	tokenRatio float64
+
+	mu     sync.Mutex
+	tokens float64
+}
+
+func (b *retryBudget) success() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens += b.tokenRatio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// failure records a failure, and reports whether a retry is allowed.
+func (b *retryBudget) failure() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens--
+	if b.tokens < 0 {
+		b.tokens = 0
+	}
+
+	return b.tokens > b.maxTokens/2
+}
@@ -0,0 +1,95 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion/nodiontest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetZones_retry(t *testing.T) {
+	client, server := setupTestMux(t, WithRetry(3, time.Millisecond))
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	server.InjectFaults(http.MethodGet, "/dns_zones",
+		nodiontest.FaultRateLimited(""),
+		nodiontest.FaultStatus(http.StatusServiceUnavailable),
+	)
+
+	zones, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Len(t, zones, 1)
+	assert.Equal(t, RetryStats{Attempts: 3, Retries: 2}, client.RetryStats())
+}
+
+func TestClient_GetZones_retry_exhausted(t *testing.T) {
+	var counter atomic.Int32
+
+	client := setupTest(t, "/dns_zones", countingHandler(&counter, func(rw http.ResponseWriter, req *http.Request) {
+		http.Error(rw, `{"status": 503, "error": "Service Unavailable"}`, http.StatusServiceUnavailable)
+	}), WithRetry(2, time.Millisecond))
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+
+	assert.EqualValues(t, 3, counter.Load())
+}
+
+func TestClient_GetZones_retry_clientError(t *testing.T) {
+	var counter atomic.Int32
+
+	client := setupTest(t, "/dns_zones",
+		countingHandler(&counter, readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-error.json")),
+		WithRetry(2, time.Millisecond))
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestClient_CreateZone_noRetry(t *testing.T) {
+	var counter atomic.Int32
+
+	client := setupTest(t, "/dns_zones", countingHandler(&counter, func(rw http.ResponseWriter, req *http.Request) {
+		http.Error(rw, `{"status": 503, "error": "Service Unavailable"}`, http.StatusServiceUnavailable)
+	}), WithRetry(2, time.Millisecond))
+
+	_, err := client.CreateZone(context.Background(), "example.com")
+	require.Error(t, err)
+
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestClient_retryBudget(t *testing.T) {
+	var counter atomic.Int32
+
+	client := setupTest(t, "/dns_zones", countingHandler(&counter, func(rw http.ResponseWriter, req *http.Request) {
+		http.Error(rw, `{"status": 503, "error": "Service Unavailable"}`, http.StatusServiceUnavailable)
+	}), WithRetry(3, time.Millisecond), WithRetryBudget(4, 0.5))
+
+	// the budget (4 tokens) allows a retry while there are more than 2 tokens:
+	// 1st failure: 3 tokens, retry; 2nd failure: 2 tokens, throttled.
+	_, err := client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+
+	assert.EqualValues(t, 2, counter.Load())
+	assert.Equal(t, RetryStats{Attempts: 2, Retries: 1, Throttled: 1}, client.RetryStats())
+
+	// no more retry until the budget is refilled by successes.
+	_, err = client.GetZones(context.Background(), nil)
+	require.Error(t, err)
+
+	assert.EqualValues(t, 3, counter.Load())
+}
+
+func TestWithRetryBudget_withoutRetry(t *testing.T) {
+	_, err := NewClient("secret", WithRetryBudget(10, 0.1))
+	require.EqualError(t, err, "the retry budget requires WithRetry")
+}
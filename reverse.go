@@ -0,0 +1,134 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// ReverseZone returns the name of the reverse zone (in-addr.arpa or ip6.arpa) of a network.
+// The prefix length must be a multiple of 8 for IPv4, and a multiple of 4 for IPv6 (a zero-length prefix is rejected).
+// An IPv4-mapped IPv6 prefix (::ffff:0:0/96 and longer) is handled as an IPv4 prefix.
+func ReverseZone(prefix netip.Prefix) (string, error) {
+	labels, n, err := reverseLabels(prefix, prefix.Addr())
+	if err != nil {
+		return "", err
+	}
+
+	return joinReversed(labels[:n]) + "." + arpaSuffix(prefix.Addr()), nil
+}
+
+// ReverseRecordName returns the name of the PTR record of an IP address,
+// relative to the reverse zone of the network (see ReverseZone).
+func ReverseRecordName(prefix netip.Prefix, addr netip.Addr) (string, error) {
+	if !prefix.Contains(addr) {
+		return "", fmt.Errorf("the address %s is not in the network %s", addr, prefix)
+	}
+
+	labels, n, err := reverseLabels(prefix, addr)
+	if err != nil {
+		return "", err
+	}
+
+	if n == len(labels) {
+		return "@", nil
+	}
+
+	return joinReversed(labels[n:]), nil
+}
+
+// CreateReverseZone creates the reverse zone of a network.
+func (c Client) CreateReverseZone(ctx context.Context, prefix netip.Prefix) (*Zone, error) {
+	name, err := ReverseZone(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateZone(ctx, name)
+}
+
+// CreatePTRRecord creates a PTR record, pointing to target, for an IP address inside the reverse zone of a network.
+func (c Client) CreatePTRRecord(ctx context.Context, zoneID string, prefix netip.Prefix, addr netip.Addr, target string, ttl int) (*Record, error) {
+	name, err := ReverseRecordName(prefix, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	record := Record{
+		RecordType: TypePTR,
+		Name:       name,
+		Content:    target,
+		TTL:        ttl,
+	}
+
+	return c.CreateRecord(ctx, zoneID, record)
+}
+
+// reverseLabels returns the labels of the address (octets for IPv4, nibbles for IPv6) in the address order,
+// and the number of labels that belong to the network.
+func reverseLabels(prefix netip.Prefix, addr netip.Addr) ([]string, int, error) {
+	if !prefix.IsValid() {
+		return nil, 0, errors.New("invalid network")
+	}
+
+	bits := prefix.Bits()
+
+	// IPv4-mapped IPv6 prefix: the length is related to the IPv4 address.
+	if prefix.Addr().Is4In6() {
+		if bits < 96 {
+			return nil, 0, fmt.Errorf("the IPv4-mapped network %s must have a prefix length of at least 96", prefix)
+		}
+
+		bits -= 96
+	}
+
+	if bits == 0 {
+		return nil, 0, fmt.Errorf("the network %s has no reverse zone: the prefix length must not be zero", prefix)
+	}
+
+	addr = addr.Unmap()
+
+	var labels []string
+
+	var unit int
+
+	if addr.Is4() {
+		unit = 8
+
+		for _, b := range addr.As4() {
+			labels = append(labels, strconv.Itoa(int(b)))
+		}
+	} else {
+		unit = 4
+
+		for _, b := range addr.As16() {
+			labels = append(labels, strconv.FormatUint(uint64(b>>4), 16), strconv.FormatUint(uint64(b&0xf), 16))
+		}
+	}
+
+	if bits%unit != 0 {
+		return nil, 0, fmt.Errorf("the prefix length of %s must be a multiple of %d", prefix, unit)
+	}
+
+	return labels, bits / unit, nil
+}
+
+func joinReversed(labels []string) string {
+	reversed := make([]string, 0, len(labels))
+	for i := len(labels) - 1; i >= 0; i-- {
+		reversed = append(reversed, labels[i])
+	}
+
+	return strings.Join(reversed, ".")
+}
+
+func arpaSuffix(addr netip.Addr) string {
+	if addr.Unmap().Is4() {
+		return "in-addr.arpa"
+	}
+
+	return "ip6.arpa"
+}
@@ -0,0 +1,109 @@
+package nodion
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseZone(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		prefix   string
+		expected string
+	}{
+		{
+			desc:     "IPv4 /24",
+			prefix:   "192.0.2.0/24",
+			expected: "2.0.192.in-addr.arpa",
+		},
+		{
+			desc:     "IPv4 /16",
+			prefix:   "198.51.0.0/16",
+			expected: "51.198.in-addr.arpa",
+		},
+		{
+			desc:     "IPv6 /48",
+			prefix:   "2001:db8:1234::/48",
+			expected: "4.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+		{
+			desc:     "IPv4-mapped /104",
+			prefix:   "::ffff:10.0.0.0/104",
+			expected: "10.in-addr.arpa",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			zone, err := ReverseZone(netip.MustParsePrefix(test.prefix))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, zone)
+		})
+	}
+}
+
+func TestReverseZone_invalidPrefix(t *testing.T) {
+	testCases := []string{
+		"192.0.2.0/25",
+		"0.0.0.0/0",
+		"::/0",
+		"::ffff:0.0.0.0/96",
+		"::ffff:0.0.0.0/88",
+	}
+
+	for _, prefix := range testCases {
+		prefix := prefix
+		t.Run(prefix, func(t *testing.T) {
+			_, err := ReverseZone(netip.MustParsePrefix(prefix))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReverseRecordName(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		prefix   string
+		addr     string
+		expected string
+	}{
+		{
+			desc:     "IPv4 /24",
+			prefix:   "192.0.2.0/24",
+			addr:     "192.0.2.10",
+			expected: "10",
+		},
+		{
+			desc:     "IPv4 /16",
+			prefix:   "198.51.0.0/16",
+			addr:     "198.51.100.7",
+			expected: "7.100",
+		},
+		{
+			desc:     "IPv6 /112",
+			prefix:   "2001:db8::/112",
+			addr:     "2001:db8::1",
+			expected: "1.0.0.0",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			name, err := ReverseRecordName(netip.MustParsePrefix(test.prefix), netip.MustParseAddr(test.addr))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, name)
+		})
+	}
+}
+
+func TestReverseRecordName_outsideNetwork(t *testing.T) {
+	_, err := ReverseRecordName(netip.MustParsePrefix("192.0.2.0/24"), netip.MustParseAddr("192.0.3.1"))
+	require.Error(t, err)
+}
@@ -0,0 +1,245 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrScheduledPlanNotFound is returned when a scheduled plan cannot be found.
+var ErrScheduledPlanNotFound = errors.New("scheduled plan not found")
+
+// ScheduledPlan is a plan to apply at a time (see Scheduler).
+type ScheduledPlan struct {
+	ID   string    `json:"id"`
+	At   time.Time `json:"at"`
+	Plan *Plan     `json:"plan"`
+}
+
+// PlanStore persists the pending scheduled plans, so they survive the restarts of the Scheduler.
+type PlanStore interface {
+	// ListPlans returns the pending plans.
+	ListPlans(ctx context.Context) ([]ScheduledPlan, error)
+	// SavePlan stores a pending plan.
+	SavePlan(ctx context.Context, plan ScheduledPlan) error
+	// DeletePlan removes a plan (applied or canceled).
+	DeletePlan(ctx context.Context, id string) error
+}
+
+// SchedulerOptions are the options of NewScheduler.
+type SchedulerOptions struct {
+	// Store persists the pending plans (optional).
+	Store PlanStore
+
+	// OnApplied is called after the application of each plan, with the created records or the error (optional).
+	OnApplied func(plan ScheduledPlan, created []Record, err error)
+}
+
+// Scheduler applies plans (see PlanZoneSync) at a given time, e.g. to flip DNS at 02:00 UTC.
+// The plans are applied by Run with ApplyPlan.
+// A plan is removed from the store before its application: it's applied at most once, even if it fails.
+// The time of the plans follows the clock of the client: a fake clock must implement TimerClock.
+type Scheduler struct {
+	client    Client
+	store     PlanStore
+	onApplied func(plan ScheduledPlan, created []Record, err error)
+
+	mu      sync.Mutex
+	pending map[string]ScheduledPlan
+	wake    chan struct{}
+}
+
+// NewScheduler creates a new Scheduler.
+func NewScheduler(client *Client, opts SchedulerOptions) *Scheduler {
+	return &Scheduler{
+		client:    *client,
+		store:     opts.Store,
+		onApplied: opts.OnApplied,
+		pending:   make(map[string]ScheduledPlan),
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Schedule schedules the application of a plan at a time.
+// A plan scheduled in the past is applied as soon as possible.
+// It returns the ID of the scheduled plan.
+func (s *Scheduler) Schedule(ctx context.Context, at time.Time, plan *Plan) (string, error) {
+	if plan == nil {
+		return "", errors.New("the plan is required")
+	}
+
+	scheduled := ScheduledPlan{ID: s.client.idGenerator.NewID(), At: at, Plan: plan}
+
+	if s.store != nil {
+		err := s.store.SavePlan(ctx, scheduled)
+		if err != nil {
+			return "", fmt.Errorf("save plan: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	s.pending[scheduled.ID] = scheduled
+	s.mu.Unlock()
+
+	s.notify()
+
+	return scheduled.ID, nil
+}
+
+// Cancel cancels a pending plan.
+func (s *Scheduler) Cancel(ctx context.Context, id string) error {
+	s.mu.Lock()
+	_, ok := s.pending[id]
+	delete(s.pending, id)
+	s.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrScheduledPlanNotFound, id)
+	}
+
+	if s.store != nil {
+		err := s.store.DeletePlan(ctx, id)
+		if err != nil {
+			return fmt.Errorf("delete plan: %w", err)
+		}
+	}
+
+	s.notify()
+
+	return nil
+}
+
+// Pending returns the pending plans, sorted by time.
+func (s *Scheduler) Pending() []ScheduledPlan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	plans := make([]ScheduledPlan, 0, len(s.pending))
+	for _, plan := range s.pending {
+		plans = append(plans, plan)
+	}
+
+	sort.Slice(plans, func(i, j int) bool { return plans[i].At.Before(plans[j].At) })
+
+	return plans
+}
+
+// Run loads the pending plans from the store, and applies the plans at their time, until the context is done.
+// It returns the context error, or the error of the store.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.store != nil {
+		plans, err := s.store.ListPlans(ctx)
+		if err != nil {
+			return fmt.Errorf("list plans: %w", err)
+		}
+
+		s.mu.Lock()
+		for _, plan := range plans {
+			s.pending[plan.ID] = plan
+		}
+		s.mu.Unlock()
+	}
+
+	for {
+		due, next := s.due()
+
+		for _, plan := range due {
+			err := s.apply(ctx, plan)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(due) > 0 {
+			continue
+		}
+
+		err := s.wait(ctx, next)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// wait waits for the time of the next plan (if not zero), a change of the pending plans, or the end of the context.
+func (s *Scheduler) wait(ctx context.Context, next time.Time) error {
+	var timer <-chan time.Time
+
+	if !next.IsZero() {
+		var stop func()
+
+		timer, stop = s.client.after(next.Sub(s.client.clock.Now()))
+		defer stop()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.wake:
+	case <-timer:
+	}
+
+	return nil
+}
+
+// due returns the plans to apply now, and the time of the next pending plan.
+func (s *Scheduler) due() ([]ScheduledPlan, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.client.clock.Now()
+
+	var due []ScheduledPlan
+
+	var next time.Time
+
+	for _, plan := range s.pending {
+		switch {
+		case !plan.At.After(now):
+			due = append(due, plan)
+		case next.IsZero() || plan.At.Before(next):
+			next = plan.At
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].At.Before(due[j].At) })
+
+	return due, next
+}
+
+func (s *Scheduler) apply(ctx context.Context, plan ScheduledPlan) error {
+	s.mu.Lock()
+	_, ok := s.pending[plan.ID]
+	delete(s.pending, plan.ID)
+	s.mu.Unlock()
+
+	if !ok {
+		// canceled in the meantime.
+		return nil
+	}
+
+	if s.store != nil {
+		err := s.store.DeletePlan(ctx, plan.ID)
+		if err != nil {
+			return fmt.Errorf("delete plan %s: %w", plan.ID, err)
+		}
+	}
+
+	created, err := s.client.ApplyPlan(ctx, plan.Plan)
+
+	if s.onApplied != nil {
+		s.onApplied(plan, created, err)
+	}
+
+	return nil
+}
+
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,241 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonPlanStore is a PlanStore storing the plans as JSON.
+type jsonPlanStore struct {
+	mu    sync.Mutex
+	plans map[string][]byte
+}
+
+func (s *jsonPlanStore) ListPlans(_ context.Context) ([]ScheduledPlan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var plans []ScheduledPlan
+
+	for _, raw := range s.plans {
+		var plan ScheduledPlan
+
+		err := json.Unmarshal(raw, &plan)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+func (s *jsonPlanStore) SavePlan(_ context.Context, plan ScheduledPlan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	s.plans[plan.ID] = raw
+
+	return nil
+}
+
+func (s *jsonPlanStore) DeletePlan(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.plans, id)
+
+	return nil
+}
+
+func TestScheduler(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	})
+
+	ctx := context.Background()
+
+	plan, err := client.PlanZoneSync(ctx, "zzz", []Record{
+		{RecordType: TypeA, Name: "www", Content: "198.51.100.1", TTL: 3600},
+	}, SyncOptions{})
+	require.NoError(t, err)
+
+	store := &jsonPlanStore{plans: map[string][]byte{}}
+
+	// scheduled by a previous instance of the scheduler.
+	previous := NewScheduler(client, SchedulerOptions{Store: store})
+
+	id, err := previous.Schedule(ctx, time.Now().Add(50*time.Millisecond), plan)
+	require.NoError(t, err)
+
+	_, err = previous.Schedule(ctx, time.Now().Add(time.Hour), plan)
+	require.NoError(t, err)
+
+	applied := make(chan string, 1)
+
+	scheduler := NewScheduler(client, SchedulerOptions{
+		Store: store,
+		OnApplied: func(plan ScheduledPlan, created []Record, err error) {
+			assert.NoError(t, err)
+			assert.Len(t, created, 1)
+
+			applied <- plan.ID
+		},
+	})
+
+	ctxRun, cancel := context.WithCancel(ctx)
+
+	done := make(chan error, 1)
+
+	go func() { done <- scheduler.Run(ctxRun) }()
+
+	select {
+	case appliedID := <-applied:
+		assert.Equal(t, id, appliedID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the plan has not been applied")
+	}
+
+	assert.Equal(t, []string{"www a 198.51.100.1"}, api.snapshot())
+
+	pending := scheduler.Pending()
+	require.Len(t, pending, 1)
+
+	err = scheduler.Cancel(ctx, pending[0].ID)
+	require.NoError(t, err)
+
+	assert.Empty(t, scheduler.Pending())
+	assert.Empty(t, store.plans)
+
+	cancel()
+
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestScheduler_Cancel_notFound(t *testing.T) {
+	client, _ := setupFakeAPI(t, nil)
+
+	err := NewScheduler(client, SchedulerOptions{}).Cancel(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrScheduledPlanNotFound)
+}
+
+// manualClock is a TimerClock advanced by the tests.
+type manualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []manualTimer
+}
+
+type manualTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *manualClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := manualTimer{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.waiters = append(c.waiters, timer)
+
+	return timer.c
+}
+
+func (c *manualClock) pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	var waiters []manualTimer
+
+	for _, timer := range c.waiters {
+		if timer.at.After(c.now) {
+			waiters = append(waiters, timer)
+			continue
+		}
+
+		timer.c <- c.now
+	}
+
+	c.waiters = waiters
+}
+
+func TestScheduler_timerClock(t *testing.T) {
+	clock := &manualClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	}, WithClock(clock))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	plan, err := client.PlanZoneSync(ctx, "zzz", []Record{
+		{RecordType: TypeA, Name: "www", Content: "198.51.100.1", TTL: 3600},
+	}, SyncOptions{})
+	require.NoError(t, err)
+
+	applied := make(chan struct{}, 1)
+
+	scheduler := NewScheduler(client, SchedulerOptions{
+		OnApplied: func(_ ScheduledPlan, _ []Record, err error) {
+			assert.NoError(t, err)
+
+			applied <- struct{}{}
+		},
+	})
+
+	_, err = scheduler.Schedule(ctx, clock.Now().Add(2*time.Hour), plan)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	require.Eventually(t, func() bool { return clock.pending() > 0 }, 5*time.Second, time.Millisecond)
+
+	clock.Advance(time.Hour)
+
+	assert.Equal(t, []string{"www a 192.0.2.1"}, api.snapshot())
+
+	clock.Advance(time.Hour)
+
+	select {
+	case <-applied:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the plan has not been applied")
+	}
+
+	assert.Equal(t, []string{"www a 198.51.100.1"}, api.snapshot())
+
+	cancel()
+
+	require.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,151 @@
+package nodion
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ErrZoneNotAllowed is returned by the operations on a zone outside the allowlist of the client (see WithAllowedZones).
+var ErrZoneNotAllowed = errors.New("zone not allowed")
+
+// WithAllowedZones restricts the client to a list of zones, identified by their IDs.
+// The operations on the other zones return ErrZoneNotAllowed, and the other zones are not listed.
+// It can be combined with WithAllowedZoneNames.
+func WithAllowedZones(ids ...string) Option {
+	return func(c *Client) error {
+		if len(ids) == 0 {
+			return errors.New("at least one zone ID is required")
+		}
+
+		if c.scope == nil {
+			c.scope = &zoneScope{ids: map[string]struct{}{}}
+		}
+
+		for _, id := range ids {
+			c.scope.ids[id] = struct{}{}
+		}
+
+		return nil
+	}
+}
+
+// WithAllowedZoneNames restricts the client to the zones matching name patterns (see path.Match, e.g. `*.example.com`).
+// The operations on the other zones return ErrZoneNotAllowed, and the other zones are not listed.
+// The names are resolved from the zone IDs with an additional call to the API (see WithCache).
+// Only the zones matching the patterns can be created.
+// It can be combined with WithAllowedZones.
+func WithAllowedZoneNames(patterns ...string) Option {
+	return func(c *Client) error {
+		if len(patterns) == 0 {
+			return errors.New("at least one zone name pattern is required")
+		}
+
+		for _, pattern := range patterns {
+			_, err := path.Match(pattern, "")
+			if err != nil {
+				return fmt.Errorf("invalid zone name pattern %q: %w", pattern, err)
+			}
+		}
+
+		if c.scope == nil {
+			c.scope = &zoneScope{ids: map[string]struct{}{}}
+		}
+
+		c.scope.patterns = append(c.scope.patterns, patterns...)
+
+		return nil
+	}
+}
+
+// zoneScope is the allowlist of the zones of a client.
+// A nil zoneScope allows all the zones.
+type zoneScope struct {
+	ids      map[string]struct{}
+	patterns []string
+}
+
+func (s *zoneScope) allowZone(zone Zone) bool {
+	if s == nil {
+		return true
+	}
+
+	if _, ok := s.ids[zone.ID]; ok {
+		return true
+	}
+
+	return s.allowName(zone.Name)
+}
+
+func (s *zoneScope) allowName(name string) bool {
+	if s == nil {
+		return true
+	}
+
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	for _, pattern := range s.patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *zoneScope) filterZones(zones []Zone) []Zone {
+	if s == nil {
+		return zones
+	}
+
+	var result []Zone
+
+	for _, zone := range zones {
+		if s.allowZone(zone) {
+			result = append(result, zone)
+		}
+	}
+
+	return result
+}
+
+// checkZone refuses the requests related to a zone outside the allowlist.
+func (c Client) checkZone(req *http.Request) error {
+	if c.scope == nil {
+		return nil
+	}
+
+	zoneID := zoneIDFromPath(c.baseURL, req.URL)
+	if zoneID == "" {
+		return nil
+	}
+
+	if _, ok := c.scope.ids[zoneID]; ok {
+		return nil
+	}
+
+	if len(c.scope.patterns) > 0 {
+		// the listed zones are filtered: a zone is found only if its name is allowed.
+		_, err := c.getZone(req.Context(), zoneID)
+		if err == nil {
+			return nil
+		}
+
+		if !errors.Is(err, ErrZoneNotFound) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrZoneNotAllowed, zoneID)
+}
+
+// checkZoneName refuses the creation of a zone outside the allowlist.
+func (c Client) checkZoneName(name string) error {
+	if c.scope.allowName(name) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrZoneNotAllowed, name)
+}
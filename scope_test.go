@@ -0,0 +1,77 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAllowedZones(t *testing.T) {
+	client, mux := setupTestMux(t, WithAllowedZones(sampleZoneID))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	mux.HandleFixture(http.MethodGet, "/dns_zones/"+sampleZoneID+"/records", http.StatusOK, "get-dns-zones-records.json")
+
+	zones, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, zones, 1)
+
+	_, err = client.GetRecords(context.Background(), sampleZoneID, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetRecords(context.Background(), "other", nil)
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+
+	_, err = client.DeleteZone(context.Background(), "other")
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+
+	err = client.WalkRecords(context.Background(), "other", nil, func(Record) error { return nil })
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+
+	_, err = client.CreateZone(context.Background(), "example.com")
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+
+	assert.Len(t, mux.Requests(), 2)
+}
+
+func TestWithAllowedZoneNames(t *testing.T) {
+	client, mux := setupTestMux(t, WithAllowedZoneNames("*.example.com"))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+
+	zones, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, zones)
+
+	var walked int
+
+	err = client.WalkZones(context.Background(), nil, func(Zone) error {
+		walked++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, walked)
+
+	_, err = client.GetRecords(context.Background(), sampleZoneID, nil)
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+
+	_, err = client.CreateZone(context.Background(), "nodionsample.com")
+	require.ErrorIs(t, err, ErrZoneNotAllowed)
+}
+
+func TestWithAllowedZoneNames_match(t *testing.T) {
+	client, mux := setupTestMux(t, WithAllowedZoneNames("nodion*.com"))
+
+	mux.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	mux.HandleFixture(http.MethodGet, "/dns_zones/"+sampleZoneID+"/records", http.StatusOK, "get-dns-zones-records.json")
+
+	records, err := client.GetRecords(context.Background(), sampleZoneID, nil)
+	require.NoError(t, err)
+	assert.Len(t, records, 5)
+
+	_, err = NewClient("secret", WithAllowedZoneNames("[a-"))
+	require.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultSearchConcurrency = 5
+
+// RecordQuery is the search criteria of SearchRecords.
+// Name, RecordType, and Content are regular expressions, an empty value matches everything.
+type RecordQuery struct {
+	Name       string
+	RecordType string
+	Content    string
+
+	// Concurrency is the maximum number of zones fetched in parallel (default: 5).
+	Concurrency int
+}
+
+// SearchRecords searches the records matching the query across all the zones.
+// The ZoneID of the returned records is always set.
+func (c Client) SearchRecords(ctx context.Context, query RecordQuery) ([]Record, error) {
+	matcher, err := newRecordMatcher(query)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := c.GetZones(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := query.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultSearchConcurrency
+	}
+
+	group, ctxGroup := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+
+	var mu sync.Mutex
+
+	var matches []Record
+
+	for _, zone := range zones {
+		zone := zone
+
+		group.Go(func() error {
+			records, errG := c.GetRecords(ctxGroup, zone.ID, nil)
+			if errG != nil {
+				return fmt.Errorf("zone %s: %w", zone.Name, errG)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, record := range records {
+				if !matcher.match(record) {
+					continue
+				}
+
+				record.ZoneID = zone.ID
+				matches = append(matches, record)
+			}
+
+			return nil
+		})
+	}
+
+	err = group.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+type recordMatcher struct {
+	name       *regexp.Regexp
+	recordType *regexp.Regexp
+	content    *regexp.Regexp
+}
+
+func newRecordMatcher(query RecordQuery) (*recordMatcher, error) {
+	var err error
+
+	matcher := &recordMatcher{}
+
+	matcher.name, err = compileQuery("name", query.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher.recordType, err = compileQuery("record type", query.RecordType)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher.content, err = compileQuery("content", query.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return matcher, nil
+}
+
+func (m *recordMatcher) match(record Record) bool {
+	return matchQuery(m.name, record.Name) &&
+		matchQuery(m.recordType, string(record.RecordType)) &&
+		matchQuery(m.content, record.Content)
+}
+
+func compileQuery(field, expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+
+	exp, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s query: %w", field, err)
+	}
+
+	return exp, nil
+}
+
+func matchQuery(exp *regexp.Regexp, value string) bool {
+	return exp == nil || exp.MatchString(value)
+}
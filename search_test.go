@@ -0,0 +1,33 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SearchRecords(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	mux.HandleFunc("/dns_zones/52be5f1b-fee7-4a42-b668-85890c41be5b/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	records, err := client.SearchRecords(context.Background(), RecordQuery{RecordType: "^ns$", Content: `^ns1\.`})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+
+	assert.Equal(t, "a10acb05-c76f-4170-9e27-74bb9a6c6cdc", records[0].ID)
+	assert.Equal(t, "52be5f1b-fee7-4a42-b668-85890c41be5b", records[0].ZoneID)
+}
+
+func TestClient_SearchRecords_invalidQuery(t *testing.T) {
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	_, err = client.SearchRecords(context.Background(), RecordQuery{Name: "("})
+	require.Error(t, err)
+}
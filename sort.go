@@ -0,0 +1,118 @@
+package nodion
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SortField is a field used to sort the zones or the records.
+type SortField string
+
+// Sort fields.
+const (
+	SortByName      SortField = "name"
+	SortByType      SortField = "type" // records only.
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+)
+
+// ErrSortNotStreamed is returned by WalkZones and WalkRecords when a sort is requested: the streamed items cannot be sorted.
+var ErrSortNotStreamed = errors.New("the sort is not supported by the streaming listings")
+
+func validateSort(field SortField, records bool) error {
+	switch field {
+	case "", SortByName, SortByCreatedAt, SortByUpdatedAt:
+		return nil
+	case SortByType:
+		if records {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid sort field: %q", field)
+}
+
+// sortZones sorts the zones (client-side, the API doesn't support the sort).
+// The ties are broken by ID, so the order is stable between calls.
+func sortZones(zones []Zone, field SortField, descending bool) {
+	if field == "" {
+		return
+	}
+
+	sort.SliceStable(zones, func(i, j int) bool {
+		a, b := zones[i], zones[j]
+		if descending {
+			a, b = b, a
+		}
+
+		switch field {
+		case SortByCreatedAt:
+			if c := compareTime(a.CreatedAt, b.CreatedAt); c != 0 {
+				return c < 0
+			}
+		case SortByUpdatedAt:
+			if c := compareTime(a.UpdatedAt, b.UpdatedAt); c != 0 {
+				return c < 0
+			}
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		return a.ID < b.ID
+	})
+}
+
+// sortRecords sorts the records (client-side, the API doesn't support the sort).
+// The ties are broken by name, type, and ID, so the order is stable between calls.
+func sortRecords(records []Record, field SortField, descending bool) {
+	if field == "" {
+		return
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if descending {
+			a, b = b, a
+		}
+
+		switch field {
+		case SortByType:
+			if a.RecordType != b.RecordType {
+				return a.RecordType < b.RecordType
+			}
+		case SortByCreatedAt:
+			if c := compareTime(a.CreatedAt, b.CreatedAt); c != 0 {
+				return c < 0
+			}
+		case SortByUpdatedAt:
+			if c := compareTime(a.UpdatedAt, b.UpdatedAt); c != 0 {
+				return c < 0
+			}
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		if a.RecordType != b.RecordType {
+			return a.RecordType < b.RecordType
+		}
+
+		return a.ID < b.ID
+	})
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
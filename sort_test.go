@@ -0,0 +1,84 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetRecords_sort(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	records, err := client.GetRecords(context.Background(), "xxx", &RecordsFilter{SortBy: SortByName})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"*", "@", "@", "@", "www"}, recordNames(records))
+	assert.Equal(t, []RecordType{TypeA, TypeA, TypeNS, TypeNS, TypeA}, recordTypes(records))
+
+	records, err = client.GetRecords(context.Background(), "xxx", &RecordsFilter{SortBy: SortByType, Descending: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"@", "@", "www", "@", "*"}, recordNames(records))
+
+	_, err = client.GetRecords(context.Background(), "xxx", &RecordsFilter{SortBy: "ttl"})
+	require.EqualError(t, err, `invalid sort field: "ttl"`)
+}
+
+func TestClient_GetZones_sort(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+
+	_, err := client.GetZones(context.Background(), &ZonesFilter{SortBy: SortByType})
+	require.EqualError(t, err, `invalid sort field: "type"`)
+
+	zones, err := client.GetZones(context.Background(), &ZonesFilter{SortBy: SortByName, Descending: true})
+	require.NoError(t, err)
+
+	for i := 1; i < len(zones); i++ {
+		assert.GreaterOrEqual(t, zones[i-1].Name, zones[i].Name)
+	}
+}
+
+func TestClient_WalkRecords_sort(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	err := client.WalkRecords(context.Background(), "xxx", &RecordsFilter{SortBy: SortByName}, func(Record) error { return nil })
+	require.ErrorIs(t, err, ErrSortNotStreamed)
+}
+
+func Test_sortRecords(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []Record{
+		{ID: "3", Name: "b", CreatedAt: base},
+		{ID: "1", Name: "a", CreatedAt: base.Add(time.Hour)},
+		{ID: "2", Name: "c", CreatedAt: base},
+	}
+
+	sortRecords(records, SortByCreatedAt, false)
+	assert.Equal(t, []string{"b", "c", "a"}, recordNames(records))
+
+	sortRecords(records, SortByCreatedAt, true)
+	assert.Equal(t, []string{"a", "c", "b"}, recordNames(records))
+}
+
+func recordNames(records []Record) []string {
+	var names []string
+	for _, record := range records {
+		names = append(names, record.Name)
+	}
+
+	return names
+}
+
+func recordTypes(records []Record) []RecordType {
+	var types []RecordType
+	for _, record := range records {
+		types = append(types, record.RecordType)
+	}
+
+	return types
+}
@@ -0,0 +1,202 @@
+package nodion
+
+import "time"
+
+// ZoneSpec is the desired state of a zone, meant to be embedded in the resources of infrastructure-as-code controllers.
+type ZoneSpec struct {
+	Name    string       `json:"name" yaml:"name"`
+	Records []RecordSpec `json:"records,omitempty" yaml:"records,omitempty"`
+}
+
+// RecordSpec is the desired state of a record.
+type RecordSpec struct {
+	Type    RecordType `json:"type" yaml:"type"`
+	Name    string     `json:"name" yaml:"name"`
+	Content string     `json:"content" yaml:"content"`
+	// TTL is optional: without TTL, the TTL of an existing record is kept,
+	// and a new record gets the default TTL (of the TTL policy, or of the API).
+	TTL int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+}
+
+// ZoneObservedState is the state of a zone as observed from the API.
+type ZoneObservedState struct {
+	ID        string                `json:"id" yaml:"id"`
+	Name      string                `json:"name" yaml:"name"`
+	CreatedAt *time.Time            `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time            `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	Records   []RecordObservedState `json:"records,omitempty" yaml:"records,omitempty"`
+}
+
+// RecordObservedState is the state of a record as observed from the API.
+type RecordObservedState struct {
+	ID        string     `json:"id" yaml:"id"`
+	Type      RecordType `json:"type" yaml:"type"`
+	Name      string     `json:"name" yaml:"name"`
+	Content   string     `json:"content" yaml:"content"`
+	TTL       int        `json:"ttl" yaml:"ttl"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// Record returns the record to create for the spec.
+func (s RecordSpec) Record() Record {
+	return Record{RecordType: s.Type, Name: s.Name, Content: s.Content, TTL: s.TTL}
+}
+
+// DesiredRecords returns the records of the spec, as expected by PlanZoneSync.
+// The records without TTL have a TTL of 0, which PlanZoneSync treats as "keep the existing TTL".
+func (s *ZoneSpec) DesiredRecords() []Record {
+	records := make([]Record, 0, len(s.Records))
+	for _, spec := range s.Records {
+		records = append(records, spec.Record())
+	}
+
+	return records
+}
+
+// DeepCopyInto copies the receiver into out.
+func (s *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
+	*out = *s
+
+	if s.Records != nil {
+		out.Records = make([]RecordSpec, len(s.Records))
+		copy(out.Records, s.Records)
+	}
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (s *ZoneSpec) DeepCopy() *ZoneSpec {
+	if s == nil {
+		return nil
+	}
+
+	out := new(ZoneSpec)
+	s.DeepCopyInto(out)
+
+	return out
+}
+
+// DeepCopyInto copies the receiver into out.
+func (s *RecordSpec) DeepCopyInto(out *RecordSpec) {
+	*out = *s
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (s *RecordSpec) DeepCopy() *RecordSpec {
+	if s == nil {
+		return nil
+	}
+
+	out := new(RecordSpec)
+	s.DeepCopyInto(out)
+
+	return out
+}
+
+// DeepCopyInto copies the receiver into out.
+func (s *ZoneObservedState) DeepCopyInto(out *ZoneObservedState) {
+	*out = *s
+	out.CreatedAt = copyTime(s.CreatedAt)
+	out.UpdatedAt = copyTime(s.UpdatedAt)
+
+	if s.Records != nil {
+		out.Records = make([]RecordObservedState, len(s.Records))
+		for i := range s.Records {
+			s.Records[i].DeepCopyInto(&out.Records[i])
+		}
+	}
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (s *ZoneObservedState) DeepCopy() *ZoneObservedState {
+	if s == nil {
+		return nil
+	}
+
+	out := new(ZoneObservedState)
+	s.DeepCopyInto(out)
+
+	return out
+}
+
+// DeepCopyInto copies the receiver into out.
+func (s *RecordObservedState) DeepCopyInto(out *RecordObservedState) {
+	*out = *s
+	out.CreatedAt = copyTime(s.CreatedAt)
+	out.UpdatedAt = copyTime(s.UpdatedAt)
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (s *RecordObservedState) DeepCopy() *RecordObservedState {
+	if s == nil {
+		return nil
+	}
+
+	out := new(RecordObservedState)
+	s.DeepCopyInto(out)
+
+	return out
+}
+
+// ObservedState extracts the observed state of a zone, with the records (if not nil) or the records of the zone.
+func (z Zone) ObservedState(records []Record) ZoneObservedState {
+	if records == nil {
+		records = z.Records
+	}
+
+	state := ZoneObservedState{
+		ID:        z.ID,
+		Name:      z.Name,
+		CreatedAt: observedTime(z.CreatedAt),
+		UpdatedAt: observedTime(z.UpdatedAt),
+	}
+
+	for _, record := range records {
+		state.Records = append(state.Records, record.ObservedState())
+	}
+
+	return state
+}
+
+// ObservedState extracts the observed state of a record.
+func (r Record) ObservedState() RecordObservedState {
+	return RecordObservedState{
+		ID:        r.ID,
+		Type:      r.RecordType,
+		Name:      r.Name,
+		Content:   r.Content,
+		TTL:       r.TTL,
+		CreatedAt: observedTime(r.CreatedAt),
+		UpdatedAt: observedTime(r.UpdatedAt),
+	}
+}
+
+// Spec returns the spec matching the observed state of a zone.
+func (s *ZoneObservedState) Spec() ZoneSpec {
+	spec := ZoneSpec{Name: s.Name}
+
+	for _, record := range s.Records {
+		spec.Records = append(spec.Records, RecordSpec{Type: record.Type, Name: record.Name, Content: record.Content, TTL: record.TTL})
+	}
+
+	return spec
+}
+
+// observedTime returns nil for a zero time, so the time is omitted from the observed state.
+func observedTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	c := *t
+
+	return &c
+}
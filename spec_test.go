@@ -0,0 +1,113 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestZoneSpec_DeepCopy(t *testing.T) {
+	spec := &ZoneSpec{
+		Name:    "example.com",
+		Records: []RecordSpec{{Type: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}},
+	}
+
+	cp := spec.DeepCopy()
+	assert.Equal(t, spec, cp)
+
+	cp.Records[0].Content = "2.2.2.2"
+	assert.Equal(t, "1.1.1.1", spec.Records[0].Content)
+
+	assert.Nil(t, (*ZoneSpec)(nil).DeepCopy())
+}
+
+func TestZoneSpec_serialization(t *testing.T) {
+	spec := ZoneSpec{
+		Name:    "example.com",
+		Records: []RecordSpec{{Type: TypeA, Name: "www", Content: "1.1.1.1"}},
+	}
+
+	raw, err := json.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"name":"example.com","records":[{"type":"a","name":"www","content":"1.1.1.1"}]}`, string(raw))
+
+	var fromYAML ZoneSpec
+
+	err = yaml.Unmarshal([]byte("name: example.com\nrecords:\n  - type: a\n    name: www\n    content: 1.1.1.1\n"), &fromYAML)
+	require.NoError(t, err)
+
+	assert.Equal(t, spec, fromYAML)
+}
+
+func TestZoneSpec_DesiredRecords_withoutTTL(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 3600},
+	})
+
+	var spec ZoneSpec
+
+	err := yaml.Unmarshal([]byte("name: example.com\nrecords:\n  - type: a\n    name: www\n    content: 1.1.1.1\n  - type: a\n    name: api\n    content: 2.2.2.2\n"), &spec)
+	require.NoError(t, err)
+
+	plan, err := client.PlanZoneSync(context.Background(), "zzz", spec.DesiredRecords(), SyncOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "+ a api \"2.2.2.2\" (ttl 0)\n", plan.String())
+	assert.Len(t, plan.Unchanged, 1)
+}
+
+func TestZone_ObservedState(t *testing.T) {
+	created := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	zone := Zone{
+		ID:        "zzz",
+		Name:      "example.com",
+		CreatedAt: created,
+		Records: []Record{
+			{ID: "r1", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60, ZoneID: "zzz", CreatedAt: created},
+		},
+	}
+
+	state := zone.ObservedState(nil)
+
+	expected := ZoneObservedState{
+		ID:        "zzz",
+		Name:      "example.com",
+		CreatedAt: &created,
+		Records: []RecordObservedState{
+			{ID: "r1", Type: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60, CreatedAt: &created},
+		},
+	}
+
+	assert.Equal(t, expected, state)
+
+	spec := state.Spec()
+	assert.Equal(t, []Record{{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}}, spec.DesiredRecords())
+
+	raw, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"id": "zzz",
+		"name": "example.com",
+		"created_at": "2023-01-01T10:00:00Z",
+		"records": [{"id": "r1", "type": "a", "name": "www", "content": "1.1.1.1", "ttl": 60, "created_at": "2023-01-01T10:00:00Z"}]
+	}`, string(raw))
+
+	raw, err = yaml.Marshal(state)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(raw), "created_at: 2023-01-01T10:00:00Z")
+	assert.NotContains(t, string(raw), "updated_at")
+
+	stateCopy := state.DeepCopy()
+	*stateCopy.CreatedAt = time.Time{}
+
+	assert.Equal(t, created, *state.CreatedAt)
+}
@@ -0,0 +1,103 @@
+package nodion
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+)
+
+// ZoneStatus is the state of a zone.
+// The Nodion API doesn't expose a verification/activation state,
+// so the delegation of the zone is checked with a DNS resolution of the NS records of the zone name.
+type ZoneStatus struct {
+	Zone Zone
+
+	// RecordCount is the number of records of the zone.
+	RecordCount int
+
+	// Nameservers are the nameservers of the zone (the NS records of the apex).
+	Nameservers []string
+
+	// DelegatedNameservers are the nameservers returned by the DNS resolution of the zone name.
+	DelegatedNameservers []string
+
+	// DelegationError is the error of the DNS resolution, if any.
+	DelegationError string
+
+	// Delegated is true when the zone name is delegated only to the nameservers of the zone.
+	Delegated bool
+}
+
+// WithResolver sets the DNS resolver used to check the delegation of the zones (GetZoneStatus).
+func WithResolver(resolver *net.Resolver) Option {
+	return func(c *Client) error {
+		c.lookupNS = resolver.LookupNS
+		return nil
+	}
+}
+
+// GetZoneStatus gets the state of a zone: records, nameservers, and delegation.
+func (c Client) GetZoneStatus(ctx context.Context, zoneID string) (*ZoneStatus, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ZoneStatus{
+		Zone:        *zone,
+		RecordCount: len(records),
+	}
+
+	for _, record := range records {
+		if isApexNS(record) {
+			status.Nameservers = append(status.Nameservers, normalizeHost(record.Content))
+		}
+	}
+
+	sort.Strings(status.Nameservers)
+
+	nss, err := c.lookupNS(ctx, zone.Name)
+	if err != nil {
+		status.DelegationError = err.Error()
+		return status, nil
+	}
+
+	for _, ns := range nss {
+		status.DelegatedNameservers = append(status.DelegatedNameservers, normalizeHost(ns.Host))
+	}
+
+	sort.Strings(status.DelegatedNameservers)
+
+	status.Delegated = isDelegated(status.Nameservers, status.DelegatedNameservers)
+
+	return status, nil
+}
+
+func isDelegated(nameservers, delegated []string) bool {
+	if len(delegated) == 0 {
+		return false
+	}
+
+	known := map[string]struct{}{}
+	for _, ns := range nameservers {
+		known[ns] = struct{}{}
+	}
+
+	for _, ns := range delegated {
+		if _, ok := known[ns]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
@@ -0,0 +1,40 @@
+package nodion
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetZoneStatus(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	client.lookupNS = func(_ context.Context, name string) ([]*net.NS, error) {
+		assert.Equal(t, "nodionsample.com", name)
+
+		return []*net.NS{{Host: "NS2.nodion.com."}, {Host: "ns1.nodion.com."}}, nil
+	}
+
+	status, err := client.GetZoneStatus(context.Background(), sampleZoneID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, status.RecordCount)
+	assert.Equal(t, []string{"ns1.nodion.com", "ns2.nodion.com"}, status.Nameservers)
+	assert.Equal(t, []string{"ns1.nodion.com", "ns2.nodion.com"}, status.DelegatedNameservers)
+	assert.True(t, status.Delegated)
+}
+
+func Test_isDelegated(t *testing.T) {
+	nameservers := []string{"ns1.nodion.com", "ns2.nodion.com"}
+
+	assert.True(t, isDelegated(nameservers, []string{"ns1.nodion.com"}))
+	assert.False(t, isDelegated(nameservers, []string{"ns1.nodion.com", "ns1.example.com"}))
+	assert.False(t, isDelegated(nameservers, nil))
+}
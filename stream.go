@@ -0,0 +1,215 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WalkZones lists the zones and calls fn for each zone.
+// The response is decoded while it's read: the zones are not buffered in memory.
+// The cache and the deduplication of the requests are not used (they require the whole response),
+// the retries apply until the response is received.
+// The iteration is stopped if fn returns an error.
+func (c Client) WalkZones(ctx context.Context, filter *ZonesFilter, fn func(zone Zone) error) error {
+	if filter != nil && filter.SortBy != "" {
+		return ErrSortNotStreamed
+	}
+
+	req, err := c.newZonesRequest(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.stream(req, "dns_zones", func(dec *json.Decoder) error {
+		var zone Zone
+
+		err := c.decodeItem(req, dec, "dns_zones", &zone)
+		if err != nil {
+			return fmt.Errorf("decode zone: %w", err)
+		}
+
+		if !c.scope.allowZone(zone) {
+			return nil
+		}
+
+		decodeRecords(zone.Records)
+
+		return fn(zone)
+	})
+}
+
+// WalkRecords lists the records of a zone and calls fn for each record.
+// The response is decoded while it's read: the records are not buffered in memory.
+// The cache and the deduplication of the requests are not used (they require the whole response),
+// the retries apply until the response is received.
+// The iteration is stopped if fn returns an error.
+func (c Client) WalkRecords(ctx context.Context, zoneID string, filter *RecordsFilter, fn func(record Record) error) error {
+	if filter != nil && filter.SortBy != "" {
+		return ErrSortNotStreamed
+	}
+
+	req, err := c.newRecordsRequest(ctx, zoneID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.stream(req, "records", func(dec *json.Decoder) error {
+		var record Record
+
+		err := c.decodeItem(req, dec, "records", &record)
+		if err != nil {
+			return fmt.Errorf("decode record: %w", err)
+		}
+
+		records, err := c.applyTags(ctx, filterRecordTypes(decodeRecords([]Record{record}), filter), filter)
+		if err != nil {
+			return err
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		return fn(records[0])
+	})
+}
+
+// stream sends a request and decodes the items of the array field of the response, one by one.
+func (c Client) stream(req *http.Request, field string, decodeItem func(dec *json.Decoder) error) error {
+	resetCallInfo(req.Context())
+
+	err := c.checkZone(req)
+	if err != nil {
+		return err
+	}
+
+	req, cancel, err := c.prepare(req)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+
+	resp, err := c.openStream(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return decodeList(resp.Body, field, decodeItem)
+}
+
+// openStream sends a request with the retries of the client (see send), the caller must close the body of the response.
+func (c Client) openStream(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.roundTrip(req)
+
+		delay, ok := c.retrier.retry(req, attempt, err)
+		if !ok {
+			return resp, err
+		}
+
+		if sleep(req.Context(), delay) != nil {
+			return nil, err
+		}
+	}
+}
+
+// decodeItem decodes the next item of the decoder with the JSON codec of the client,
+// and reports the unknown fields of the item (see WithSchemaDriftHandler).
+func (c Client) decodeItem(req *http.Request, dec *json.Decoder, field string, v any) error {
+	if _, ok := c.codec.(stdJSONCodec); ok && c.drift == nil {
+		return dec.Decode(v)
+	}
+
+	var raw json.RawMessage
+
+	err := dec.Decode(&raw)
+	if err != nil {
+		return err
+	}
+
+	err = c.codec.Unmarshal(raw, v)
+	if err != nil {
+		return err
+	}
+
+	c.drift.detectAt(req, raw, v, field+"[]")
+
+	return nil
+}
+
+// decodeList decodes, token-wise, the array field of a JSON object.
+// The other fields are skipped.
+func decodeList(r io.Reader, field string, decodeItem func(dec *json.Decoder) error) error {
+	dec := json.NewDecoder(r)
+
+	err := expectDelim(dec, '{')
+	if err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("read key: %w", err)
+		}
+
+		key, _ := tok.(string)
+		if key != field {
+			var skip json.RawMessage
+
+			err = dec.Decode(&skip)
+			if err != nil {
+				return fmt.Errorf("skip field %s: %w", key, err)
+			}
+
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return fmt.Errorf("read field %s: %w", field, err)
+		}
+
+		if tok == nil {
+			continue
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("field %s: expected an array, got %v", field, tok)
+		}
+
+		for dec.More() {
+			err = decodeItem(dec)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = expectDelim(dec, ']')
+		if err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, expected json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != expected {
+		return errors.New("invalid response body: expected " + expected.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion/nodiontest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WalkRecords(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	var names []string
+
+	err := client.WalkRecords(context.Background(), "xxx", nil, func(record Record) error {
+		names = append(names, record.Name)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"@", "*", "www", "@", "@"}, names)
+}
+
+func TestClient_WalkZones_stop(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+
+	errStop := errors.New("stop")
+
+	err := client.WalkZones(context.Background(), nil, func(zone Zone) error {
+		assert.Equal(t, "nodionsample.com", zone.Name)
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+}
+
+func TestClient_WalkZones_error(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-error.json"))
+
+	err := client.WalkZones(context.Background(), nil, func(zone Zone) error { return nil })
+	require.Error(t, err)
+}
+
+func TestClient_WalkZones_retry(t *testing.T) {
+	client, server := setupTestMux(t, WithRetry(3, time.Millisecond))
+
+	server.HandleFixture(http.MethodGet, "/dns_zones", http.StatusOK, "get-dns-zones.json")
+	server.InjectFaults(http.MethodGet, "/dns_zones", nodiontest.FaultStatus(http.StatusBadGateway))
+
+	var names []string
+
+	err := client.WalkZones(context.Background(), nil, func(zone Zone) error {
+		names = append(names, zone.Name)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"nodionsample.com"}, names)
+	assert.Equal(t, RetryStats{Attempts: 2, Retries: 1}, client.RetryStats())
+}
+
+func TestClient_WalkZones_schemaDrift(t *testing.T) {
+	var drifts []SchemaDrift
+
+	client := setupTest(t, "/dns_zones", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`{"dns_zones": [{"id": "zzz", "name": "example.com", "dnssec": true}]}`))
+	}, WithSchemaDriftHandler(func(_ context.Context, drift SchemaDrift) {
+		drifts = append(drifts, drift)
+	}))
+
+	err := client.WalkZones(context.Background(), nil, func(zone Zone) error { return nil })
+	require.NoError(t, err)
+
+	expected := []SchemaDrift{{
+		Method:   http.MethodGet,
+		Endpoint: "/dns_zones",
+		Fields:   []string{"dns_zones[].dnssec"},
+	}}
+
+	assert.Equal(t, expected, drifts)
+}
+
+func Test_decodeList(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     string
+		expected []string
+		assert   require.ErrorAssertionFunc
+	}{
+		{
+			desc:     "other fields",
+			data:     `{"meta": {"a": [1, 2]}, "items": ["a", "b"], "foo": "bar"}`,
+			expected: []string{"a", "b"},
+			assert:   require.NoError,
+		},
+		{
+			desc:   "null",
+			data:   `{"items": null}`,
+			assert: require.NoError,
+		},
+		{
+			desc:   "not an array",
+			data:   `{"items": "a"}`,
+			assert: require.Error,
+		},
+		{
+			desc:   "not an object",
+			data:   `["a"]`,
+			assert: require.Error,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			var items []string
+
+			err := decodeList(strings.NewReader(test.data), "items", func(dec *json.Decoder) error {
+				var item string
+
+				err := dec.Decode(&item)
+				items = append(items, item)
+
+				return err
+			})
+			test.assert(t, err)
+
+			assert.Equal(t, test.expected, items)
+		})
+	}
+}
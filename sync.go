@@ -0,0 +1,181 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SyncOptions are the options of PlanZoneSync.
+type SyncOptions struct {
+	// ManageApexNS includes the NS records of the apex in the reconciliation.
+	// By default, they are managed by Nodion and left untouched.
+	ManageApexNS bool
+}
+
+// Plan is the set of changes required to reconcile the records of a zone with the desired records.
+// A record is identified by its type, its name (matched with RelativeName), and its content (compared as with Record.EquivalentTo):
+// a record with another TTL is replaced, unless the desired TTL is 0 (the existing TTL is kept).
+type Plan struct {
+	ZoneID   string
+	ZoneName string
+
+	// Create are the records to create.
+	Create []Record
+	// Delete are the existing records to delete.
+	Delete []Record
+	// Unchanged are the existing records matching a desired record.
+	Unchanged []Record
+}
+
+// Empty reports whether the plan has no change.
+func (p *Plan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Delete) == 0
+}
+
+// String returns a human-readable representation of the changes (`+` creation, `-` deletion).
+func (p *Plan) String() string {
+	var b strings.Builder
+
+	for _, record := range p.Create {
+		_, _ = fmt.Fprintf(&b, "+ %s\n", formatRecord(record))
+	}
+
+	for _, record := range p.Delete {
+		_, _ = fmt.Fprintf(&b, "- %s\n", formatRecord(record))
+	}
+
+	return b.String()
+}
+
+// PlanZoneSync computes the changes required to reconcile the records of a zone with the desired records.
+// Nothing is changed, the plan is applied with ApplyPlan.
+func (c Client) PlanZoneSync(ctx context.Context, zoneID string, desired []Record, opts SyncOptions) (*Plan, error) {
+	zone, err := c.getZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffRecords(zone, existing, desired, opts), nil
+}
+
+// ApplyPlan applies a plan with a ChangeSet (the creations before the deletions).
+// A CNAME record can't coexist with another record with the same name:
+// the deletions of the names with a CNAME record (to create or to delete) are applied before the creations.
+// It returns the created records.
+func (c Client) ApplyPlan(ctx context.Context, plan *Plan) ([]Record, error) {
+	cnameNames := map[string]struct{}{}
+
+	for _, record := range append(append([]Record{}, plan.Create...), plan.Delete...) {
+		if isCNAME(record) {
+			cnameNames[RelativeName(record.Name, plan.ZoneName)] = struct{}{}
+		}
+	}
+
+	var lastDeletes []Record
+
+	changeSet := c.BeginChangeSet(plan.ZoneID)
+
+	for _, record := range plan.Delete {
+		if _, ok := cnameNames[RelativeName(record.Name, plan.ZoneName)]; !ok {
+			lastDeletes = append(lastDeletes, record)
+			continue
+		}
+
+		changeSet.Delete(record)
+	}
+
+	for _, record := range plan.Create {
+		changeSet.Add(record)
+	}
+
+	for _, record := range lastDeletes {
+		changeSet.Delete(record)
+	}
+
+	return changeSet.Commit(ctx)
+}
+
+func diffRecords(zone *Zone, existing, desired []Record, opts SyncOptions) *Plan {
+	plan := &Plan{ZoneID: zone.ID, ZoneName: zone.Name}
+
+	wanted := map[string]Record{}
+
+	var keys []string
+
+	for _, record := range desired {
+		record = Record{
+			RecordType: record.RecordType.normalized(),
+			Name:       RelativeName(record.Name, zone.Name),
+			Content:    record.Content,
+			TTL:        record.TTL,
+		}
+
+		key := recordKey(record)
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+
+		wanted[key] = record
+		keys = append(keys, key)
+	}
+
+	found := map[string]struct{}{}
+
+	for _, record := range existing {
+		normalized := Record{
+			RecordType: record.RecordType,
+			Name:       RelativeName(record.Name, zone.Name),
+			Content:    record.Content,
+			TTL:        record.TTL,
+		}
+
+		if !opts.ManageApexNS && isApexNS(normalized) {
+			continue
+		}
+
+		key := recordKey(normalized)
+
+		_, duplicate := found[key]
+
+		target, ok := wanted[key]
+		if !ok || duplicate {
+			plan.Delete = append(plan.Delete, record)
+			continue
+		}
+
+		found[key] = struct{}{}
+
+		// a desired TTL of 0 keeps the existing TTL.
+		if target.TTL != 0 && target.TTL != record.TTL {
+			plan.Delete = append(plan.Delete, record)
+			plan.Create = append(plan.Create, target)
+
+			continue
+		}
+
+		plan.Unchanged = append(plan.Unchanged, record)
+	}
+
+	for _, key := range keys {
+		if _, ok := found[key]; !ok {
+			plan.Create = append(plan.Create, wanted[key])
+		}
+	}
+
+	return plan
+}
+
+// recordKey identifies a record by its type, its name (relative), and its content (normalized).
+func recordKey(record Record) string {
+	return fmt.Sprintf("%s\x00%s\x00%s", record.RecordType, record.Name, normalizeContent(record.RecordType, record.Content))
+}
+
+func formatRecord(record Record) string {
+	return fmt.Sprintf("%s %s %q (ttl %d)", record.RecordType, record.Name, record.Content, record.TTL)
+}
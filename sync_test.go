@@ -0,0 +1,118 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PlanZoneSync(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeNS, Name: "@", Content: "ns1.nodion.com", TTL: 3600},
+		{RecordType: TypeA, Name: "@", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		{RecordType: TypeTXT, Name: "old", Content: "foo", TTL: 3600},
+	})
+
+	desired := []Record{
+		{RecordType: TypeA, Name: "example.com", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www.example.com.", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 3600},
+		{RecordType: TypeMX, Name: "@", Content: "10 mail.example.com", TTL: 3600},
+	}
+
+	plan, err := client.PlanZoneSync(context.Background(), "zzz", desired, SyncOptions{})
+	require.NoError(t, err)
+
+	assert.False(t, plan.Empty())
+
+	expected := `+ a www "1.1.1.1" (ttl 3600)
++ mx @ "10 mail.example.com" (ttl 3600)
+- a www "1.1.1.1" (ttl 60)
+- txt old "foo" (ttl 3600)
+`
+	assert.Equal(t, expected, plan.String())
+	require.Len(t, plan.Unchanged, 1)
+
+	created, err := client.ApplyPlan(context.Background(), plan)
+	require.NoError(t, err)
+
+	assert.Len(t, created, 2)
+
+	expectedRecords := []string{
+		"@ ns ns1.nodion.com",
+		"@ a 1.1.1.1",
+		"www a 1.1.1.1",
+		"@ mx 10 mail.example.com",
+	}
+	assert.Equal(t, expectedRecords, api.snapshot())
+
+	plan, err = client.PlanZoneSync(context.Background(), "zzz", desired, SyncOptions{})
+	require.NoError(t, err)
+
+	assert.True(t, plan.Empty())
+}
+
+func TestClient_PlanZoneSync_manageApexNS(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeNS, Name: "@", Content: "ns1.nodion.com", TTL: 3600},
+	})
+
+	plan, err := client.PlanZoneSync(context.Background(), "zzz", nil, SyncOptions{ManageApexNS: true})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Delete, 1)
+	assert.Equal(t, TypeNS, plan.Delete[0].RecordType)
+}
+
+func TestClient_PlanZoneSync_keepTTL(t *testing.T) {
+	client, _ := setupFakeAPI(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 3600},
+		{RecordType: TypeCNAME, Name: "blog", Content: "Example.org.", TTL: 3600},
+	})
+
+	desired := []Record{
+		{RecordType: TypeA, Name: "www", Content: "1.1.1.1"},
+		{RecordType: "CNAME", Name: "blog", Content: "example.org"},
+	}
+
+	plan, err := client.PlanZoneSync(context.Background(), "zzz", desired, SyncOptions{})
+	require.NoError(t, err)
+
+	assert.True(t, plan.Empty())
+	assert.Len(t, plan.Unchanged, 2)
+}
+
+func TestClient_ApplyPlan_cname(t *testing.T) {
+	client, api := setupFakeAPI(t, []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "example.org", TTL: 3600},
+		{RecordType: TypeA, Name: "blog", Content: "1.1.1.1", TTL: 3600},
+	}, WithCNAMEConflictCheck())
+
+	desired := []Record{
+		{RecordType: TypeCNAME, Name: "www", Content: "example.net"},
+		{RecordType: TypeCNAME, Name: "blog", Content: "example.org", TTL: 60},
+	}
+
+	plan, err := client.PlanZoneSync(context.Background(), "zzz", desired, SyncOptions{})
+	require.NoError(t, err)
+
+	_, err = client.ApplyPlan(context.Background(), plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www cname example.net", "blog cname example.org"}, api.snapshot())
+
+	// the CNAME TTL changes are applied too.
+	desired[0].TTL = 300
+
+	plan, err = client.PlanZoneSync(context.Background(), "zzz", desired, SyncOptions{})
+	require.NoError(t, err)
+
+	_, err = client.ApplyPlan(context.Background(), plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"blog cname example.org", "www cname example.net"}, api.snapshot())
+	assert.Equal(t, []int{60, 300}, recordTTLs(api.sorted()))
+}
@@ -0,0 +1,132 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrTagStoreDisabled is returned when tags are used without a TagStore.
+var ErrTagStoreDisabled = errors.New("tag store is disabled")
+
+// TagStore stores the tags of the records.
+// The Nodion API doesn't support metadata on records, so the tags are managed by the client.
+type TagStore interface {
+	// GetTags returns the tags of a record.
+	GetTags(ctx context.Context, recordID string) (map[string]string, error)
+	// SetTags replaces the tags of a record.
+	SetTags(ctx context.Context, recordID string, tags map[string]string) error
+	// DeleteTags removes the tags of a record.
+	DeleteTags(ctx context.Context, recordID string) error
+}
+
+// WithTagStore enables the tags of the records:
+// the tags are stored on record creation, removed on record deletion, and populated by GetRecords.
+func WithTagStore(store TagStore) Option {
+	return func(c *Client) error {
+		c.tagStore = store
+		return nil
+	}
+}
+
+// SetRecordTags replaces the tags of an existing record.
+func (c Client) SetRecordTags(ctx context.Context, recordID string, tags map[string]string) error {
+	if c.tagStore == nil {
+		return ErrTagStoreDisabled
+	}
+
+	return c.tagStore.SetTags(ctx, recordID, tags)
+}
+
+// applyTags populates the tags of the records, and filters them.
+func (c Client) applyTags(ctx context.Context, records []Record, filter *RecordsFilter) ([]Record, error) {
+	if c.tagStore == nil {
+		if filter != nil && len(filter.Tags) > 0 {
+			return nil, ErrTagStoreDisabled
+		}
+
+		return records, nil
+	}
+
+	var result []Record
+
+	for _, record := range records {
+		tags, err := c.tagStore.GetTags(ctx, record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get tags of the record %s: %w", record.ID, err)
+		}
+
+		record.Tags = tags
+
+		if filter != nil && !hasTags(record.Tags, filter.Tags) {
+			continue
+		}
+
+		result = append(result, record)
+	}
+
+	return result, nil
+}
+
+func hasTags(tags, expected map[string]string) bool {
+	for k, v := range expected {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MemoryTagStore is an in-memory TagStore.
+type MemoryTagStore struct {
+	mu   sync.RWMutex
+	tags map[string]map[string]string
+}
+
+// NewMemoryTagStore creates a new MemoryTagStore.
+func NewMemoryTagStore() *MemoryTagStore {
+	return &MemoryTagStore{tags: make(map[string]map[string]string)}
+}
+
+// GetTags returns the tags of a record.
+func (m *MemoryTagStore) GetTags(_ context.Context, recordID string) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return copyTags(m.tags[recordID]), nil
+}
+
+// SetTags replaces the tags of a record.
+func (m *MemoryTagStore) SetTags(_ context.Context, recordID string, tags map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tags[recordID] = copyTags(tags)
+
+	return nil
+}
+
+// DeleteTags removes the tags of a record.
+func (m *MemoryTagStore) DeleteTags(_ context.Context, recordID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tags, recordID)
+
+	return nil
+}
+
+func copyTags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+
+	cp := make(map[string]string, len(tags))
+	for k, v := range tags {
+		cp[k] = v
+	}
+
+	return cp
+}
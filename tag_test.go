@@ -0,0 +1,55 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetRecords_tags(t *testing.T) {
+	store := NewMemoryTagStore()
+
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"), WithTagStore(store))
+
+	err := client.SetRecordTags(context.Background(), "843fa60c-dc30-47c4-a818-fee31118a43f", map[string]string{"managed-by": "terraform"})
+	require.NoError(t, err)
+
+	records, err := client.GetRecords(context.Background(), "xxx", &RecordsFilter{Tags: map[string]string{"managed-by": "terraform"}})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, "www", records[0].Name)
+	assert.Equal(t, map[string]string{"managed-by": "terraform"}, records[0].Tags)
+}
+
+func TestClient_CreateRecord_tags(t *testing.T) {
+	store := NewMemoryTagStore()
+
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json"), WithTagStore(store))
+
+	record := Record{
+		RecordType: TypeA,
+		Name:       "www",
+		Content:    "1.2.3.4",
+		TTL:        60,
+		Tags:       map[string]string{"managed-by": "manual"},
+	}
+
+	newRecord, err := client.CreateRecord(context.Background(), "xxx", record)
+	require.NoError(t, err)
+
+	tags, err := store.GetTags(context.Background(), newRecord.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"managed-by": "manual"}, tags)
+}
+
+func TestClient_GetRecords_tagsWithoutStore(t *testing.T) {
+	client := setupTest(t, "/dns_zones/xxx/records", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json"))
+
+	_, err := client.GetRecords(context.Background(), "xxx", &RecordsFilter{Tags: map[string]string{"a": "b"}})
+	require.ErrorIs(t, err, ErrTagStoreDisabled)
+}
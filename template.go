@@ -0,0 +1,81 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var templateVariable = regexp.MustCompile(`\{([A-Za-z0-9_-]+)\}`)
+
+// Template is a named set of records.
+// The name and the content of the records can contain variables (ex: `{ip}`, `{domain}`).
+type Template struct {
+	Name    string
+	Records []Record
+}
+
+// Render returns the records of the template with the variables replaced by their values.
+func (t Template) Render(vars map[string]string) ([]Record, error) {
+	missing := map[string]struct{}{}
+
+	replace := func(s string) string {
+		return templateVariable.ReplaceAllStringFunc(s, func(match string) string {
+			key := strings.Trim(match, "{}")
+
+			value, ok := vars[key]
+			if !ok {
+				missing[key] = struct{}{}
+				return match
+			}
+
+			return value
+		})
+	}
+
+	records := make([]Record, 0, len(t.Records))
+
+	for _, record := range t.Records {
+		record.Name = replace(record.Name)
+		record.Content = replace(record.Content)
+
+		records = append(records, record)
+	}
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("template %s: missing variables: %s", t.Name, strings.Join(names, ", "))
+	}
+
+	return records, nil
+}
+
+// ApplyTemplate creates the records of the template in a zone.
+// In case of error, the records already created are returned along with the error.
+func (c Client) ApplyTemplate(ctx context.Context, zoneID string, tmpl Template, vars map[string]string) ([]Record, error) {
+	records, err := tmpl.Render(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []Record
+
+	for _, record := range records {
+		newRecord, err := c.CreateRecord(ctx, zoneID, record)
+		if err != nil {
+			return created, fmt.Errorf("template %s: create record %s (%s): %w", tmpl.Name, record.Name, record.RecordType, err)
+		}
+
+		created = append(created, *newRecord)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,45 @@
+package nodion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_Render(t *testing.T) {
+	tmpl := Template{
+		Name: "web",
+		Records: []Record{
+			{RecordType: TypeA, Name: "@", Content: "{ip}", TTL: 3600},
+			{RecordType: TypeCNAME, Name: "www", Content: "{domain}", TTL: 3600},
+			{RecordType: TypeTXT, Name: "@", Content: "v=spf1 ip4:{ip} -all", TTL: 3600},
+		},
+	}
+
+	records, err := tmpl.Render(map[string]string{"ip": "1.2.3.4", "domain": "example.com"})
+	require.NoError(t, err)
+
+	expected := []Record{
+		{RecordType: TypeA, Name: "@", Content: "1.2.3.4", TTL: 3600},
+		{RecordType: TypeCNAME, Name: "www", Content: "example.com", TTL: 3600},
+		{RecordType: TypeTXT, Name: "@", Content: "v=spf1 ip4:1.2.3.4 -all", TTL: 3600},
+	}
+
+	assert.Equal(t, expected, records)
+
+	// the template itself is not modified.
+	assert.Equal(t, "{ip}", tmpl.Records[0].Content)
+}
+
+func TestTemplate_Render_missingVariables(t *testing.T) {
+	tmpl := Template{
+		Name: "web",
+		Records: []Record{
+			{RecordType: TypeA, Name: "{sub}", Content: "{ip}"},
+		},
+	}
+
+	_, err := tmpl.Render(map[string]string{"foo": "bar"})
+	require.EqualError(t, err, "template web: missing variables: ip, sub")
+}
@@ -0,0 +1,216 @@
+// Package tfschema maps the zones and records to the flat structures used by the Terraform plugin SDK
+// (map[string]any with primitive values), and provides stable hashes for the sets of records.
+package tfschema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nrdcg/nodion"
+)
+
+// Keys of the flattened structures.
+const (
+	KeyID        = "id"
+	KeyZoneID    = "zone_id"
+	KeyName      = "name"
+	KeyType      = "type"
+	KeyContent   = "content"
+	KeyTTL       = "ttl"
+	KeyRecords   = "records"
+	KeyCreatedAt = "created_at"
+	KeyUpdatedAt = "updated_at"
+)
+
+// FlattenZone maps a zone (and its records) to a flat structure.
+func FlattenZone(zone nodion.Zone) map[string]any {
+	records := make([]any, 0, len(zone.Records))
+	for _, record := range zone.Records {
+		records = append(records, FlattenRecord(record))
+	}
+
+	return map[string]any{
+		KeyID:        zone.ID,
+		KeyName:      zone.Name,
+		KeyCreatedAt: formatTime(zone.CreatedAt),
+		KeyUpdatedAt: formatTime(zone.UpdatedAt),
+		KeyRecords:   records,
+	}
+}
+
+// ExpandZone maps a flat structure to a zone (the inverse of FlattenZone).
+func ExpandZone(m map[string]any) (nodion.Zone, error) {
+	var zone nodion.Zone
+
+	var err error
+
+	zone.ID, err = getString(m, KeyID)
+	if err != nil {
+		return nodion.Zone{}, err
+	}
+
+	zone.Name, err = getString(m, KeyName)
+	if err != nil {
+		return nodion.Zone{}, err
+	}
+
+	raw, ok := m[KeyRecords]
+	if !ok || raw == nil {
+		return zone, nil
+	}
+
+	items, ok := raw.([]any)
+	if !ok {
+		return nodion.Zone{}, fmt.Errorf("%s: expected a list, got %T", KeyRecords, raw)
+	}
+
+	for i, item := range items {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			return nodion.Zone{}, fmt.Errorf("%s.%d: expected a map, got %T", KeyRecords, i, item)
+		}
+
+		record, err := ExpandRecord(itemMap)
+		if err != nil {
+			return nodion.Zone{}, fmt.Errorf("%s.%d: %w", KeyRecords, i, err)
+		}
+
+		zone.Records = append(zone.Records, record)
+	}
+
+	return zone, nil
+}
+
+// FlattenRecord maps a record to a flat structure.
+func FlattenRecord(record nodion.Record) map[string]any {
+	return map[string]any{
+		KeyID:      record.ID,
+		KeyZoneID:  record.ZoneID,
+		KeyType:    string(record.RecordType),
+		KeyName:    record.Name,
+		KeyContent: record.Content,
+		KeyTTL:     record.TTL,
+	}
+}
+
+// ExpandRecord maps a flat structure to a record (the inverse of FlattenRecord).
+// The missing keys are left empty.
+func ExpandRecord(m map[string]any) (nodion.Record, error) {
+	var (
+		record     nodion.Record
+		recordType string
+	)
+
+	fields := map[string]*string{
+		KeyID:      &record.ID,
+		KeyZoneID:  &record.ZoneID,
+		KeyType:    &recordType,
+		KeyName:    &record.Name,
+		KeyContent: &record.Content,
+	}
+
+	for key, field := range fields {
+		value, err := getString(m, key)
+		if err != nil {
+			return nodion.Record{}, err
+		}
+
+		*field = value
+	}
+
+	record.RecordType = nodion.RecordType(recordType)
+
+	ttl, err := getInt(m, KeyTTL)
+	if err != nil {
+		return nodion.Record{}, err
+	}
+
+	record.TTL = ttl
+
+	return record, nil
+}
+
+// HashRecord returns a stable hash of the type, the name, the content and the TTL of a flattened record,
+// suitable as the set function of a Terraform schema.Set (non-negative int, like the SDK's hashcode.String).
+func HashRecord(v any) int {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return 0
+	}
+
+	record, err := ExpandRecord(m)
+	if err != nil {
+		return 0
+	}
+
+	h := int(crc32.ChecksumIEEE([]byte(recordKey(record))))
+	if h < 0 {
+		return -h
+	}
+
+	return h
+}
+
+// HashRecordSet returns a stable hash of a set of records:
+// the order of the records and their IDs and dates are ignored.
+func HashRecordSet(records []nodion.Record) string {
+	keys := make([]string, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, recordKey(record))
+	}
+
+	sort.Strings(keys)
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func recordKey(record nodion.Record) string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%d", record.RecordType, record.Name, record.Content, record.TTL)
+}
+
+func getString(m map[string]any, key string) (string, error) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a string, got %T", key, value)
+	}
+
+	return s, nil
+}
+
+func getInt(m map[string]any, key string) (int, error) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return 0, nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("%s: expected a number, got %T", key, value)
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
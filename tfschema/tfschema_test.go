@@ -0,0 +1,74 @@
+package tfschema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenZone(t *testing.T) {
+	zone := nodion.Zone{
+		ID:        "zzz",
+		Name:      "example.com",
+		CreatedAt: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+		Records: []nodion.Record{
+			{ID: "r1", ZoneID: "zzz", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		},
+	}
+
+	expected := map[string]any{
+		"id":         "zzz",
+		"name":       "example.com",
+		"created_at": "2023-01-01T10:00:00Z",
+		"updated_at": "",
+		"records": []any{
+			map[string]any{"id": "r1", "zone_id": "zzz", "type": "a", "name": "www", "content": "1.1.1.1", "ttl": 60},
+		},
+	}
+
+	m := FlattenZone(zone)
+	assert.Equal(t, expected, m)
+
+	expanded, err := ExpandZone(m)
+	require.NoError(t, err)
+
+	assert.Equal(t, nodion.Zone{ID: "zzz", Name: "example.com", Records: zone.Records}, expanded)
+}
+
+func TestExpandRecord(t *testing.T) {
+	record, err := ExpandRecord(map[string]any{"type": "txt", "name": "@", "content": "foo", "ttl": float64(300)})
+	require.NoError(t, err)
+
+	assert.Equal(t, nodion.Record{RecordType: nodion.TypeTXT, Name: "@", Content: "foo", TTL: 300}, record)
+
+	_, err = ExpandRecord(map[string]any{"ttl": "300"})
+	require.EqualError(t, err, "ttl: expected a number, got string")
+}
+
+func TestHashRecord(t *testing.T) {
+	a := FlattenRecord(nodion.Record{ID: "1", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60})
+	b := FlattenRecord(nodion.Record{ID: "2", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60})
+	c := FlattenRecord(nodion.Record{ID: "1", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 300})
+
+	assert.Equal(t, HashRecord(a), HashRecord(b))
+	assert.NotEqual(t, HashRecord(a), HashRecord(c))
+	assert.GreaterOrEqual(t, HashRecord(a), 0)
+}
+
+func TestHashRecordSet(t *testing.T) {
+	a := []nodion.Record{
+		{ID: "1", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		{ID: "2", RecordType: nodion.TypeA, Name: "www", Content: "2.2.2.2", TTL: 60},
+	}
+
+	b := []nodion.Record{
+		{ID: "3", RecordType: nodion.TypeA, Name: "www", Content: "2.2.2.2", TTL: 60},
+		{ID: "4", RecordType: nodion.TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+	}
+
+	assert.Equal(t, HashRecordSet(a), HashRecordSet(b))
+	assert.NotEqual(t, HashRecordSet(a), HashRecordSet(b[:1]))
+}
@@ -0,0 +1,46 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type timeoutKey struct{}
+
+// WithDefaultTimeout sets the timeout applied to each call to the API when the context of the call has no deadline
+// (default: 5 seconds).
+func WithDefaultTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return errors.New("default timeout must be positive")
+		}
+
+		c.defaultTimeout = timeout
+
+		return nil
+	}
+}
+
+// ContextWithTimeout returns a context that overrides the default timeout (see WithDefaultTimeout) for the calls using this context.
+// Unlike context.WithTimeout, the timeout is applied to each call to the API, not to the whole operation.
+func ContextWithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, timeout)
+}
+
+func (c Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, ok := ctx.Value(timeoutKey{}).(time.Duration)
+	if !ok {
+		if _, hasDeadline := ctx.Deadline(); hasDeadline {
+			return ctx, func() {}
+		}
+
+		timeout = c.defaultTimeout
+	}
+
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
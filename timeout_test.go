@@ -0,0 +1,66 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func slowHandler(delay time.Duration) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+		}
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	}
+}
+
+func TestClient_GetZones_defaultTimeout(t *testing.T) {
+	client := setupTest(t, "/dns_zones", slowHandler(time.Second), WithDefaultTimeout(50*time.Millisecond))
+
+	_, err := client.GetZones(context.Background(), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = client.GetZones(ContextWithTimeout(context.Background(), 5*time.Second), nil)
+	require.NoError(t, err)
+}
+
+func TestClient_GetZones_defaultTimeout_deadline(t *testing.T) {
+	client := setupTest(t, "/dns_zones", slowHandler(200*time.Millisecond), WithDefaultTimeout(50*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.GetZones(ctx, nil)
+	require.NoError(t, err)
+}
+
+func TestClient_GetZones_longTimeout(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+
+	require.Zero(t, client.HTTPClient.Timeout)
+
+	var remaining time.Duration
+
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		deadline, _ := req.Context().Deadline()
+		remaining = time.Until(deadline)
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	_, err := client.GetZones(ContextWithTimeout(context.Background(), time.Minute), nil)
+	require.NoError(t, err)
+
+	require.Greater(t, remaining, 50*time.Second)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.LessOrEqual(t, remaining, defaultTimeout)
+}
@@ -0,0 +1,142 @@
+package nodion
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// Timings is the timing breakdown of an HTTP request to the API (see WithTimingHandler and CallInfo).
+// With the retries, it's the breakdown of the last attempt.
+type Timings struct {
+	Method   string
+	Endpoint string
+
+	// DNS is the duration of the DNS lookup.
+	DNS time.Duration
+	// Connect is the duration of the TCP connection.
+	Connect time.Duration
+	// TLS is the duration of the TLS handshake.
+	TLS time.Duration
+	// TTFB is the duration from the start of the request to the first byte of the response (time to first byte).
+	TTFB time.Duration
+	// Total is the duration from the start of the request to the response headers (or the error).
+	Total time.Duration
+
+	// ReusedConn reports whether an idle connection was reused (without DNS lookup, connection, and TLS handshake).
+	ReusedConn bool
+}
+
+// TimingHandler is called with the timing breakdown of each HTTP request to the API.
+type TimingHandler func(ctx context.Context, timings Timings)
+
+// WithTimingHandler registers a handler called with the timing breakdown of each HTTP request to the API.
+// The timings are also available in the CallInfo of the context (see WithCallInfo).
+func WithTimingHandler(handler TimingHandler) Option {
+	return func(c *Client) error {
+		c.timingHandler = handler
+		return nil
+	}
+}
+
+// timingTrace collects the timings of a request with an httptrace.ClientTrace.
+type timingTrace struct {
+	mu sync.Mutex
+
+	start      time.Time
+	dnsStart   time.Time
+	connStart  time.Time
+	tlsStart   time.Time
+	timings    Timings
+	firstByte  time.Time
+	reusedConn bool
+}
+
+// traceTimings enables the collection of the timings of a request,
+// only if they are expected by a TimingHandler or a CallInfo.
+func (c Client) traceTimings(req *http.Request) (*http.Request, *timingTrace) {
+	if c.timingHandler == nil {
+		if info, ok := req.Context().Value(callInfoKey{}).(*CallInfo); !ok || info == nil {
+			return req, nil
+		}
+	}
+
+	t := &timingTrace{start: time.Now()}
+
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.reusedConn = info.Reused
+			t.mu.Unlock()
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.timings.DNS = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			t.connStart = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			t.timings.Connect = time.Since(t.connStart)
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.timings.TLS = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.firstByte = time.Now()
+			t.mu.Unlock()
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), t
+}
+
+// reportTimings reports the timings of a request to the TimingHandler and the CallInfo.
+func (c Client) reportTimings(req *http.Request, t *timingTrace) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+
+	timings := t.timings
+	timings.Method = req.Method
+	timings.Endpoint = req.URL.Path
+	timings.ReusedConn = t.reusedConn
+	timings.Total = time.Since(t.start)
+
+	if !t.firstByte.IsZero() {
+		timings.TTFB = t.firstByte.Sub(t.start)
+	}
+
+	t.mu.Unlock()
+
+	if info, ok := req.Context().Value(callInfoKey{}).(*CallInfo); ok && info != nil {
+		info.Timings = timings
+	}
+
+	if c.timingHandler != nil {
+		c.timingHandler(req.Context(), timings)
+	}
+}
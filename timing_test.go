@@ -0,0 +1,58 @@
+package nodion
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTimingHandler(t *testing.T) {
+	var mu sync.Mutex
+
+	var timings []Timings
+
+	handler := func(_ context.Context, t Timings) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		timings = append(timings, t)
+	}
+
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"), WithTimingHandler(handler))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetZones(context.Background(), nil)
+		require.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(t, timings, 2)
+
+	assert.Equal(t, http.MethodGet, timings[0].Method)
+	assert.Equal(t, "/dns_zones", timings[0].Endpoint)
+	assert.False(t, timings[0].ReusedConn)
+	assert.Positive(t, timings[0].Connect)
+	assert.Positive(t, timings[0].TTFB)
+	assert.GreaterOrEqual(t, timings[0].Total, timings[0].TTFB)
+
+	assert.True(t, timings[1].ReusedConn)
+	assert.Zero(t, timings[1].Connect)
+}
+
+func TestCallInfo_Timings(t *testing.T) {
+	client := setupTest(t, "/dns_zones", readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+
+	info := &CallInfo{}
+
+	_, err := client.GetZones(WithCallInfo(context.Background(), info), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/dns_zones", info.Timings.Endpoint)
+	assert.Positive(t, info.Timings.TTFB)
+}
@@ -0,0 +1,131 @@
+package nodion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NameRewriter rewrites the name of a record copied to another zone (see CopyRecords).
+// It receives the fully qualified name of the record in the source zone (without trailing dot),
+// and returns the fully qualified name of the record in the destination zone.
+// The record is skipped if it returns false.
+type NameRewriter func(name string) (string, bool)
+
+// CopyRecords copies the records of a zone matching a filter to another zone.
+// The names are rewritten with the rewriter: if nil, the fully qualified names are kept,
+// and the records outside the destination zone are skipped
+// (e.g. the records `www.sub` of `example.com` are copied as `www` in `sub.example.com`).
+// The NS records of the apex of the source zone are not copied.
+// The records are created with a ChangeSet: if a creation fails, the copied records are deleted.
+// It returns the created records.
+func (c Client) CopyRecords(ctx context.Context, srcZoneID, dstZoneID string, filter RecordsFilter, rewrite NameRewriter) ([]Record, error) {
+	created, _, err := c.copyRecords(ctx, srcZoneID, dstZoneID, filter, rewrite)
+
+	return created, err
+}
+
+// MoveRecords moves the records of a zone matching a filter to another zone (see CopyRecords):
+// the source records are deleted after the creation of all the copies.
+// If a deletion fails, the deleted source records are recreated, but the copies are kept.
+// It returns the created records.
+func (c Client) MoveRecords(ctx context.Context, srcZoneID, dstZoneID string, filter RecordsFilter, rewrite NameRewriter) ([]Record, error) {
+	created, sources, err := c.copyRecords(ctx, srcZoneID, dstZoneID, filter, rewrite)
+	if err != nil {
+		return nil, err
+	}
+
+	changeSet := c.BeginChangeSet(srcZoneID)
+
+	for _, record := range sources {
+		changeSet.Delete(record)
+	}
+
+	_, err = changeSet.Commit(ctx)
+	if err != nil {
+		return created, fmt.Errorf("delete source records: %w", err)
+	}
+
+	return created, nil
+}
+
+// copyRecords copies the records, and returns the created records and their source records.
+func (c Client) copyRecords(ctx context.Context, srcZoneID, dstZoneID string, filter RecordsFilter, rewrite NameRewriter) ([]Record, []Record, error) {
+	src, err := c.getZone(ctx, srcZoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dst, err := c.getZone(ctx, dstZoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records, err := c.GetRecords(ctx, srcZoneID, &filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changeSet := c.BeginChangeSet(dstZoneID)
+
+	var sources []Record
+
+	for _, record := range records {
+		if isApexNS(record) {
+			continue
+		}
+
+		name := fqdn(record.Name, src.Name)
+
+		if rewrite != nil {
+			var keep bool
+
+			name, keep = rewrite(name)
+			if !keep {
+				continue
+			}
+
+			if !inZone(name, dst.Name) {
+				return nil, nil, fmt.Errorf("the rewritten name %s of the record %s (%s) is outside the zone %s", name, record.Name, record.RecordType, dst.Name)
+			}
+		} else if !inZone(name, dst.Name) {
+			continue
+		}
+
+		changeSet.Add(Record{
+			RecordType: record.RecordType,
+			Name:       RelativeName(name, dst.Name),
+			Content:    record.Content,
+			TTL:        record.TTL,
+		})
+
+		sources = append(sources, record)
+	}
+
+	created, err := changeSet.Commit(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, sources, nil
+}
+
+// fqdn returns the fully qualified name (without trailing dot) of a record name relative to a zone.
+func fqdn(name, zoneName string) string {
+	name = RelativeName(name, zoneName)
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	if name == "@" {
+		return zoneName
+	}
+
+	return name + "." + zoneName
+}
+
+// inZone reports whether a fully qualified name belongs to a zone.
+func inZone(name, zoneName string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	return name == zoneName || strings.HasSuffix(name, "."+zoneName)
+}
@@ -0,0 +1,130 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTwoZones serves the zones example.com (zzz) and sub.example.com (yyy).
+func setupTwoZones(t *testing.T, src, dst []Record) (*Client, *fakeAPI, *fakeAPI) {
+	t.Helper()
+
+	client, mux := setupTestMux(t)
+
+	newAPI := func(records []Record) *fakeAPI {
+		api := &fakeAPI{records: map[string]Record{}}
+		for _, record := range records {
+			api.seq++
+			record.ID = fmt.Sprintf("id%d", api.seq)
+			api.records[record.ID] = record
+		}
+
+		return api
+	}
+
+	srcAPI, dstAPI := newAPI(src), newAPI(dst)
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(rw).Encode(ZonesResponse{Zones: []Zone{
+			{ID: "zzz", Name: "example.com"},
+			{ID: "yyy", Name: "sub.example.com"},
+		}})
+	})
+	mux.Handle("/dns_zones/zzz/", srcAPI)
+	mux.HandleFunc("/dns_zones/yyy/", func(rw http.ResponseWriter, req *http.Request) {
+		// the fake API serves only the paths of the zone zzz.
+		req.URL.Path = strings.Replace(req.URL.Path, "/yyy/", "/zzz/", 1)
+		dstAPI.ServeHTTP(rw, req)
+	})
+
+	return client, srcAPI, dstAPI
+}
+
+func TestClient_CopyRecords(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		filter   RecordsFilter
+		rewrite  NameRewriter
+		expected []string
+	}{
+		{
+			desc:     "subdomain",
+			expected: []string{"@ ns ns1.example.net", "www a 192.0.2.2", "@ a 192.0.2.3", "mail mx 10 mail.example.com"},
+		},
+		{
+			desc:     "filter",
+			filter:   RecordsFilter{RecordType: TypeMX},
+			expected: []string{"@ ns ns1.example.net", "mail mx 10 mail.example.com"},
+		},
+		{
+			desc: "rewrite",
+			rewrite: func(name string) (string, bool) {
+				if name != "www.example.com" {
+					return "", false
+				}
+
+				return "legacy.sub.example.com", true
+			},
+			expected: []string{"@ ns ns1.example.net", "legacy a 192.0.2.1"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, srcAPI, dstAPI := setupTwoZones(t, []Record{
+				{RecordType: TypeNS, Name: "@", Content: "ns1.example.com", TTL: 3600},
+				{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+				{RecordType: TypeA, Name: "www.sub", Content: "192.0.2.2", TTL: 3600},
+				{RecordType: TypeA, Name: "sub", Content: "192.0.2.3", TTL: 3600},
+				{RecordType: TypeMX, Name: "mail.sub", Content: "10 mail.example.com", TTL: 3600},
+			}, []Record{
+				{RecordType: TypeNS, Name: "@", Content: "ns1.example.net", TTL: 3600},
+			})
+
+			before := srcAPI.snapshot()
+
+			_, err := client.CopyRecords(context.Background(), "zzz", "yyy", test.filter, test.rewrite)
+			require.NoError(t, err)
+
+			assert.Equal(t, before, srcAPI.snapshot())
+			assert.Equal(t, test.expected, dstAPI.snapshot())
+		})
+	}
+}
+
+func TestClient_CopyRecords_outsideZone(t *testing.T) {
+	client, _, dstAPI := setupTwoZones(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+	}, nil)
+
+	rewrite := func(name string) (string, bool) { return name, true }
+
+	_, err := client.CopyRecords(context.Background(), "zzz", "yyy", RecordsFilter{}, rewrite)
+	require.EqualError(t, err, "the rewritten name www.example.com of the record www (a) is outside the zone sub.example.com")
+
+	assert.Empty(t, dstAPI.snapshot())
+}
+
+func TestClient_MoveRecords(t *testing.T) {
+	client, srcAPI, dstAPI := setupTwoZones(t, []Record{
+		{RecordType: TypeA, Name: "www", Content: "192.0.2.1", TTL: 3600},
+		{RecordType: TypeA, Name: "www.sub", Content: "192.0.2.2", TTL: 3600},
+	}, nil)
+
+	created, err := client.MoveRecords(context.Background(), "zzz", "yyy", RecordsFilter{}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"www"}, recordNames(created))
+	assert.Equal(t, []string{"www a 192.0.2.1"}, srcAPI.snapshot())
+	assert.Equal(t, []string{"www a 192.0.2.2"}, dstAPI.snapshot())
+}
@@ -0,0 +1,152 @@
+package nodion
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithProxy sets the URL of the proxy used to reach the API.
+func WithProxy(proxyURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("parse proxy URL: %w", err)
+		}
+
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tr.Proxy = http.ProxyURL(u)
+
+		return nil
+	}
+}
+
+// WithCABundle adds PEM encoded CA certificates to the system certificate pool
+// used to verify the certificates of the API (or of a TLS-inspecting proxy).
+func WithCABundle(pemCerts []byte) Option {
+	return func(c *Client) error {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pemCerts) {
+			return errors.New("no valid certificate in the CA bundle")
+		}
+
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tlsConfig(tr).RootCAs = pool
+
+		return nil
+	}
+}
+
+// WithClientCertificate sets the certificate presented to the server (mutual TLS).
+func WithClientCertificate(cert tls.Certificate) Option {
+	return func(c *Client) error {
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		cfg := tlsConfig(tr)
+		cfg.Certificates = append(cfg.Certificates, cert)
+
+		return nil
+	}
+}
+
+// transport returns the transport of the HTTP client,
+// a clone of http.DefaultTransport is used if the HTTP client has no transport.
+// The options related to the transport must be applied before replacing the HTTP client.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.HTTPClient.Transport == nil {
+		c.HTTPClient.Transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	tr, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unsupported HTTP transport: %T", c.HTTPClient.Transport)
+	}
+
+	return tr, nil
+}
+
+func tlsConfig(tr *http.Transport) *tls.Config {
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return tr.TLSClientConfig
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle (keep-alive) connections to the API.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(c *Client) error {
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tr.MaxIdleConnsPerHost = n
+
+		if tr.MaxIdleConns != 0 && tr.MaxIdleConns < n {
+			tr.MaxIdleConns = n
+		}
+
+		return nil
+	}
+}
+
+// WithIdleConnTimeout sets the maximum amount of time an idle (keep-alive) connection remains idle before closing itself.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tr.IdleConnTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithHTTP2Disabled disables HTTP/2.
+func WithHTTP2Disabled() Option {
+	return func(c *Client) error {
+		tr, err := c.transport()
+		if err != nil {
+			return err
+		}
+
+		tr.ForceAttemptHTTP2 = false
+		tr.TLSNextProto = make(map[string]func(authority string, c *tls.Conn) http.RoundTripper)
+
+		if tr.TLSClientConfig != nil {
+			var protos []string
+
+			for _, proto := range tr.TLSClientConfig.NextProtos {
+				if proto != "h2" {
+					protos = append(protos, proto)
+				}
+			}
+
+			tr.TLSClientConfig.NextProtos = protos
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package nodion
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	t.Cleanup(server.Close)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err := NewClient("secret", WithBaseURL(server.URL), WithCABundle(caBundle))
+	require.NoError(t, err)
+
+	zones, err := client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Len(t, zones, 1)
+}
+
+func TestWithCABundle_invalid(t *testing.T) {
+	_, err := NewClient("secret", WithCABundle([]byte("invalid")))
+	require.Error(t, err)
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied bool
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		proxied = true
+
+		assert.Equal(t, "api.nodion.test", req.Host)
+
+		readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := NewClient("secret", WithBaseURL("http://api.nodion.test/v1/"), WithProxy(proxy.URL))
+	require.NoError(t, err)
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.True(t, proxied)
+}
+
+func TestWithHTTP2Disabled(t *testing.T) {
+	server := httptest.NewUnstartedServer(readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json"))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	var proto string
+
+	client, err := NewClient("secret",
+		WithBaseURL(server.URL),
+		WithCABundle(caBundle),
+		WithHTTP2Disabled(),
+		WithMaxIdleConnsPerHost(50),
+		WithIdleConnTimeout(time.Minute),
+	)
+	require.NoError(t, err)
+
+	tr, err := client.transport()
+	require.NoError(t, err)
+
+	assert.Equal(t, 50, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Minute, tr.IdleConnTimeout)
+
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, errR := tr.RoundTrip(req)
+		if errR == nil {
+			proto = resp.Proto
+		}
+
+		return resp, errR
+	})
+
+	_, err = client.GetZones(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "HTTP/1.1", proto)
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
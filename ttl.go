@@ -0,0 +1,76 @@
+package nodion
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTTLOutOfRange is returned when the TTL of a record is rejected by the TTL policy (see WithStrictTTLPolicy).
+var ErrTTLOutOfRange = errors.New("TTL out of range")
+
+// WithTTLPolicy applies a TTL policy to the created records:
+// the TTLs are clamped to [minTTL, maxTTL], and the records without TTL get defaultTTL.
+func WithTTLPolicy(minTTL, maxTTL, defaultTTL int) Option {
+	return func(c *Client) error {
+		policy, err := newTTLPolicy(minTTL, maxTTL, defaultTTL, false)
+		if err != nil {
+			return err
+		}
+
+		c.ttlPolicy = policy
+
+		return nil
+	}
+}
+
+// WithStrictTTLPolicy applies a TTL policy to the created records:
+// the TTLs outside of [minTTL, maxTTL] are rejected with ErrTTLOutOfRange (before any request),
+// and the records without TTL get defaultTTL.
+func WithStrictTTLPolicy(minTTL, maxTTL, defaultTTL int) Option {
+	return func(c *Client) error {
+		policy, err := newTTLPolicy(minTTL, maxTTL, defaultTTL, true)
+		if err != nil {
+			return err
+		}
+
+		c.ttlPolicy = policy
+
+		return nil
+	}
+}
+
+type ttlPolicy struct {
+	min, max, defaultTTL int
+	reject               bool
+}
+
+func newTTLPolicy(minTTL, maxTTL, defaultTTL int, reject bool) (*ttlPolicy, error) {
+	if minTTL <= 0 || maxTTL < minTTL {
+		return nil, fmt.Errorf("invalid TTL range: [%d, %d]", minTTL, maxTTL)
+	}
+
+	if defaultTTL < minTTL || defaultTTL > maxTTL {
+		return nil, fmt.Errorf("the default TTL %d is outside of the range [%d, %d]", defaultTTL, minTTL, maxTTL)
+	}
+
+	return &ttlPolicy{min: minTTL, max: maxTTL, defaultTTL: defaultTTL, reject: reject}, nil
+}
+
+// apply returns the TTL allowed by the policy.
+// A nil policy allows any TTL.
+func (p *ttlPolicy) apply(ttl int) (int, error) {
+	switch {
+	case p == nil:
+		return ttl, nil
+	case ttl == 0:
+		return p.defaultTTL, nil
+	case ttl >= p.min && ttl <= p.max:
+		return ttl, nil
+	case p.reject:
+		return 0, fmt.Errorf("%w: %d is outside of [%d, %d]", ErrTTLOutOfRange, ttl, p.min, p.max)
+	case ttl < p.min:
+		return p.min, nil
+	default:
+		return p.max, nil
+	}
+}
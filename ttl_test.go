@@ -0,0 +1,47 @@
+package nodion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRecord_ttlPolicy(t *testing.T) {
+	client, api := setupFakeAPI(t, nil, WithTTLPolicy(300, 3600, 600))
+
+	for _, ttl := range []int{0, 60, 900, 86400} {
+		_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: ttl})
+		require.NoError(t, err)
+	}
+
+	var ttls []int
+	for _, record := range api.sorted() {
+		ttls = append(ttls, record.TTL)
+	}
+
+	assert.Equal(t, []int{600, 300, 900, 3600}, ttls)
+}
+
+func TestClient_CreateRecord_strictTTLPolicy(t *testing.T) {
+	client, api := setupFakeAPI(t, nil, WithStrictTTLPolicy(300, 3600, 600))
+
+	_, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60})
+	require.ErrorIs(t, err, ErrTTLOutOfRange)
+
+	assert.Empty(t, api.snapshot())
+
+	record, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeA, Name: "www", Content: "1.1.1.1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 600, record.TTL)
+}
+
+func TestWithTTLPolicy_invalid(t *testing.T) {
+	_, err := NewClient("secret", WithTTLPolicy(3600, 300, 600))
+	require.EqualError(t, err, "invalid TTL range: [3600, 300]")
+
+	_, err = NewClient("secret", WithTTLPolicy(300, 3600, 60))
+	require.EqualError(t, err, "the default TTL 60 is outside of the range [300, 3600]")
+}
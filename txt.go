@@ -0,0 +1,234 @@
+package nodion
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTXTSegment is the maximum length of a character-string of a TXT record (RFC 1035, section 3.3).
+const maxTXTSegment = 255
+
+// SplitTXT splits a TXT value into segments of at most 255 bytes.
+// The segments are not split in the middle of a UTF-8 sequence.
+func SplitTXT(value string) []string {
+	var segments []string
+
+	for len(value) > maxTXTSegment {
+		end := maxTXTSegment
+
+		// doesn't split a UTF-8 sequence: backs up to the start of the sequence.
+		for end > maxTXTSegment-utf8.UTFMax && !utf8.RuneStart(value[end]) {
+			end--
+		}
+
+		// invalid UTF-8: no start of sequence found.
+		if !utf8.RuneStart(value[end]) {
+			end = maxTXTSegment
+		}
+
+		segments = append(segments, value[:end])
+		value = value[end:]
+	}
+
+	return append(segments, value)
+}
+
+// JoinTXT joins the quoted segments of a TXT content (`"abc" "def"`) into a single value.
+// A content that is not made of quoted segments is returned as is.
+func JoinTXT(content string) string {
+	value, err := UnquoteTXT(content)
+	if err != nil {
+		return content
+	}
+
+	return value
+}
+
+// QuoteTXT returns the zone file representation of a TXT value:
+// the value is split into quoted segments of at most 255 bytes,
+// the quotes and the backslashes are escaped,
+// and the control and non-ASCII characters are escaped as `\DDD` (RFC 1035, section 5.1).
+func QuoteTXT(value string) string {
+	segments := SplitTXT(value)
+
+	quoted := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		quoted = append(quoted, quoteTXTSegment(segment))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// UnquoteTXT is the inverse of QuoteTXT:
+// it parses the quoted segments of a TXT content, and joins them into a single value.
+func UnquoteTXT(content string) (string, error) {
+	segments, err := parseTXTSegments(content)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(segments, ""), nil
+}
+
+// encodeTXT returns the content sent to the API for a TXT value:
+// the values that can't be sent as is are quoted (see QuoteTXT).
+func encodeTXT(value string) string {
+	if !needsTXTQuoting(value) {
+		return value
+	}
+
+	return QuoteTXT(value)
+}
+
+// decodeTXT returns the value of a TXT content received from the API:
+// the quoted contents are unquoted.
+func decodeTXT(content string) string {
+	return JoinTXT(content)
+}
+
+// needsTXTQuoting reports whether a TXT value is ambiguous without quotes:
+// too long, or containing quotes, backslashes, semicolons (comments in zone files),
+// control or non-ASCII characters.
+func needsTXTQuoting(value string) bool {
+	if len(value) > maxTXTSegment {
+		return true
+	}
+
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+
+		if ch == '"' || ch == '\\' || ch == ';' || ch < ' ' || ch > '~' {
+			return true
+		}
+	}
+
+	return false
+}
+
+func quoteTXTSegment(segment string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for i := 0; i < len(segment); i++ {
+		ch := segment[i]
+
+		switch {
+		case ch == '"' || ch == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		case ch < ' ' || ch > '~':
+			fmt.Fprintf(&b, `\%03d`, ch)
+		default:
+			b.WriteByte(ch)
+		}
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// parseTXTSegments parses a list of quoted segments separated by spaces.
+func parseTXTSegments(content string) ([]string, error) {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, `"`) {
+		return nil, errors.New("TXT content not quoted")
+	}
+
+	var segments []string
+
+	for content != "" {
+		if content[0] != '"' {
+			return nil, fmt.Errorf("unexpected character in TXT content: %q", content[0])
+		}
+
+		segment, n, err := parseTXTSegment(content)
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, segment)
+
+		rest := strings.TrimLeft(content[n:], " \t")
+		if rest != "" && len(rest) == len(content[n:]) {
+			return nil, errors.New("TXT segments must be separated by spaces")
+		}
+
+		content = rest
+	}
+
+	return segments, nil
+}
+
+// parseTXTSegment parses the quoted segment at the start of content,
+// and returns its value and the number of bytes read.
+func parseTXTSegment(content string) (string, int, error) {
+	var b strings.Builder
+
+	for i := 1; i < len(content); i++ {
+		ch := content[i]
+
+		switch ch {
+		case '"':
+			return b.String(), i + 1, nil
+
+		case '\\':
+			if i+3 < len(content) && isDigits(content[i+1:i+4]) {
+				code := int(content[i+1]-'0')*100 + int(content[i+2]-'0')*10 + int(content[i+3]-'0')
+				if code > 255 {
+					return "", 0, fmt.Errorf("invalid TXT escape: %q", content[i:i+4])
+				}
+
+				b.WriteByte(byte(code))
+				i += 3
+
+				continue
+			}
+
+			if i+1 >= len(content) {
+				return "", 0, errors.New("unterminated TXT escape")
+			}
+
+			b.WriteByte(content[i+1])
+			i++
+
+		default:
+			b.WriteByte(ch)
+		}
+	}
+
+	return "", 0, errors.New("unterminated TXT segment")
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeRecord prepares a record for the API.
+func encodeRecord(record Record) Record {
+	if record.RecordType == TypeTXT {
+		record.Content = encodeTXT(record.Content)
+	}
+
+	return record
+}
+
+// decodeRecords restores the records received from the API.
+func decodeRecords(records []Record) []Record {
+	for i := range records {
+		if records[i].RecordType == TypeTXT {
+			records[i].Content = decodeTXT(records[i].Content)
+		}
+	}
+
+	return records
+}
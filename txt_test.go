@@ -0,0 +1,127 @@
+package nodion
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTXT(t *testing.T) {
+	assert.Equal(t, []string{"abc"}, SplitTXT("abc"))
+	assert.Equal(t, []string{""}, SplitTXT(""))
+
+	segments := SplitTXT(strings.Repeat("a", 600))
+	require.Len(t, segments, 3)
+	assert.Len(t, segments[0], 255)
+	assert.Len(t, segments[1], 255)
+	assert.Len(t, segments[2], 90)
+
+	// doesn't split a UTF-8 sequence.
+	segments = SplitTXT(strings.Repeat("a", 254) + "é")
+	assert.Equal(t, []string{strings.Repeat("a", 254), "é"}, segments)
+
+	// invalid UTF-8: split at 255 bytes.
+	segments = SplitTXT(strings.Repeat("\x80", 300))
+	assert.Equal(t, []string{strings.Repeat("\x80", 255), strings.Repeat("\x80", 45)}, segments)
+}
+
+func TestJoinTXT(t *testing.T) {
+	testCases := []struct {
+		content  string
+		expected string
+	}{
+		{content: "v=spf1 -all", expected: "v=spf1 -all"},
+		{content: `"abc"`, expected: "abc"},
+		{content: `"abc" "def"`, expected: "abcdef"},
+		{content: `"a\"b" "c\\d"`, expected: `a"bc\d`},
+		{content: `"caf\195\169"`, expected: "café"},
+		{content: `"abc" def`, expected: `"abc" def`},
+		{content: `"abc"def"`, expected: `"abc"def"`},
+		{content: `"abc`, expected: `"abc`},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, JoinTXT(test.content), test.content)
+	}
+}
+
+func TestQuoteTXT(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "", expected: `""`},
+		{value: "v=spf1 -all", expected: `"v=spf1 -all"`},
+		{value: `say "hi"`, expected: `"say \"hi\""`},
+		{value: `a\b`, expected: `"a\\b"`},
+		{value: "v=DKIM1; k=rsa", expected: `"v=DKIM1; k=rsa"`},
+		{value: "café", expected: `"caf\195\169"`},
+		{value: "a\tb", expected: `"a\009b"`},
+	}
+
+	for _, test := range testCases {
+		quoted := QuoteTXT(test.value)
+		assert.Equal(t, test.expected, quoted, test.value)
+
+		value, err := UnquoteTXT(quoted)
+		require.NoError(t, err)
+		assert.Equal(t, test.value, value)
+	}
+}
+
+func TestUnquoteTXT_errors(t *testing.T) {
+	testCases := []struct {
+		content  string
+		expected string
+	}{
+		{content: "abc", expected: "TXT content not quoted"},
+		{content: `"abc`, expected: "unterminated TXT segment"},
+		{content: `"abc"def"`, expected: "TXT segments must be separated by spaces"},
+		{content: `"abc" def`, expected: `unexpected character in TXT content: 'd'`},
+		{content: `"\999"`, expected: `invalid TXT escape: "\\999"`},
+	}
+
+	for _, test := range testCases {
+		_, err := UnquoteTXT(test.content)
+		require.EqualError(t, err, test.expected, test.content)
+	}
+}
+
+func Test_encodeTXT(t *testing.T) {
+	assert.Equal(t, "v=spf1 -all", encodeTXT("v=spf1 -all"))
+	assert.Equal(t, `"v=DKIM1; p=abc"`, encodeTXT("v=DKIM1; p=abc"))
+
+	value := `v=DKIM1; p=` + strings.Repeat("x", 300) + `"\`
+
+	encoded := encodeTXT(value)
+	assert.Equal(t, `"v=DKIM1; p=`+strings.Repeat("x", 244)+`" "`+strings.Repeat("x", 56)+`\"\\"`, encoded)
+
+	assert.Equal(t, value, decodeTXT(encoded))
+
+	assert.Equal(t, "quoted", decodeTXT(`"quoted"`))
+}
+
+func TestClient_CreateRecord_longTXT(t *testing.T) {
+	client, api := setupFakeAPI(t, nil)
+
+	value := "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIB", 100)
+
+	record, err := client.CreateRecord(context.Background(), "zzz", Record{RecordType: TypeTXT, Name: "mail._domainkey", Content: value, TTL: 3600})
+	require.NoError(t, err)
+
+	assert.Equal(t, value, record.Content)
+
+	stored := api.sorted()
+	require.Len(t, stored, 1)
+	assert.True(t, strings.HasPrefix(stored[0].Content, `"v=DKIM1; k=rsa; p=MIIB`))
+	assert.Contains(t, stored[0].Content, `" "`)
+
+	records, err := client.GetRecords(context.Background(), "zzz", &RecordsFilter{RecordType: TypeTXT, Content: value})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, value, records[0].Content)
+}
@@ -1,24 +1,13 @@
 package nodion
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 )
 
-// Record types.
-const (
-	TypeA     = "a"
-	TypeAAAA  = "aaaa"
-	TypeNS    = "ns"
-	TypeALIAS = "alias"
-	TypeCNAME = "cname"
-	TypeMX    = "mx"
-	TypeTXT   = "txt"
-	TypePTR   = "ptr"
-	TypeSRV   = "srv"
-)
-
 // ZonesResponse represents the response of the GetZones API endpoint.
 type ZonesResponse struct {
 	Zones []Zone `json:"dns_zones"`
@@ -55,33 +44,61 @@ type Zone struct {
 
 // Record contains all the information related to a DNS record.
 type Record struct {
-	ID         string    `json:"id,omitempty"`
-	RecordType string    `json:"record_type,omitempty"` // a, aaaa, ns, alias, cname, mx, txt, ptr, srv. Case-sensitive must be in lowercase.
-	Name       string    `json:"name,omitempty"`
-	Content    string    `json:"content,omitempty"`
-	TTL        int       `json:"ttl,omitempty"` // a number between 60 and 86400.
-	ZoneID     string    `json:"zone_id,omitempty"`
-	CreatedAt  time.Time `json:"created_at,omitempty"`
-	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+	ID         string     `json:"id,omitempty"`
+	RecordType RecordType `json:"record_type,omitempty"` // a, aaaa, ns, alias, cname, mx, txt, ptr, srv.
+	Name       string     `json:"name,omitempty"`
+	Content    string     `json:"content,omitempty"`
+	TTL        int        `json:"ttl,omitempty"` // a number between 60 and 86400.
+	ZoneID     string     `json:"zone_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at,omitempty"`
+
+	// Tags are client-managed metadata (see WithTagStore), they are not sent to the API.
+	Tags map[string]string `json:"-"`
 }
 
 // ZonesFilter is filter criteria for zones.
 type ZonesFilter struct {
 	Name string `url:"name"` // must be the exact name and no FQDN
+
+	// SortBy sorts the zones (client-side, not supported by WalkZones).
+	SortBy SortField `url:"-"`
+	// Descending reverses the sort order.
+	Descending bool `url:"-"`
 }
 
 // RecordsFilter is filter criteria for records.
 type RecordsFilter struct {
-	Name       string `url:"name"`
-	RecordType string `url:"record_type"`
-	Content    string `url:"content"`
+	Name       string     `url:"name"`
+	RecordType RecordType `url:"record_type"`
+	Content    string     `url:"content"`
+
+	// RecordTypes filters the records on several types.
+	// The API supports only one type per request: with several types, the filtering is done client-side.
+	// It cannot be combined with RecordType.
+	RecordTypes []RecordType `url:"-"`
+
+	// SortBy sorts the records (client-side, not supported by WalkRecords).
+	SortBy SortField `url:"-"`
+	// Descending reverses the sort order.
+	Descending bool `url:"-"`
+
+	// Tags filters the records on their tags (client-side, see WithTagStore).
+	Tags map[string]string `url:"-"`
 }
 
+// ErrConflict is returned (wrapped in an APIError) when the server rejects a request
+// because the resource was modified concurrently (409 Conflict or 412 Precondition Failed).
+var ErrConflict = errors.New("conflict")
+
 // APIError is the error returned by the server.
 type APIError struct {
 	StatusCode int      `json:"status"`
 	Message    string   `json:"error"`
 	Errors     []string `json:"errors"`
+
+	// response is the error response (its body is closed), provided to the backoff of the retries.
+	response *http.Response
 }
 
 func (a *APIError) Error() string {
@@ -91,3 +108,13 @@ func (a *APIError) Error() string {
 
 	return fmt.Sprintf("status code %d: %s", a.StatusCode, strings.Join(a.Errors, ", "))
 }
+
+// Is reports whether the APIError matches target.
+// It allows to use errors.Is(err, ErrConflict).
+func (a *APIError) Is(target error) bool {
+	if target != ErrConflict {
+		return false
+	}
+
+	return a.StatusCode == http.StatusConflict || a.StatusCode == http.StatusPreconditionFailed
+}
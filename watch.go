@@ -0,0 +1,142 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ChangeType is the kind of change detected by WatchZone.
+type ChangeType string
+
+// Change types.
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeModified ChangeType = "modified"
+	ChangeDeleted  ChangeType = "deleted"
+)
+
+// ZoneChange is a change of a record detected by WatchZone.
+type ZoneChange struct {
+	Type ChangeType
+	Time time.Time
+
+	// Record is the new record (ChangeAdded, ChangeModified) or the deleted record (ChangeDeleted).
+	Record Record
+	// Previous is the record before the change (ChangeModified only).
+	Previous *Record
+
+	// Err is the error of a failed poll (the other fields are empty), the watch continues with the next poll.
+	Err error
+}
+
+// WatchZone polls the records of a zone at the interval, and emits the changes between two polls.
+// The first poll is done before returning: it's the reference snapshot, and its errors are returned.
+// The channel is closed when the context is done.
+//
+// The API has no update endpoint: a change is usually detected as a deletion and an addition.
+// The responses cache (see WithCache) delays the detection of the changes made by other clients.
+func (c Client) WatchZone(ctx context.Context, zoneID string, interval time.Duration) (<-chan ZoneChange, error) {
+	if interval <= 0 {
+		return nil, errors.New("the interval must be positive")
+	}
+
+	previous, err := c.snapshotRecords(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan ZoneChange)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := c.snapshotRecords(ctx, zoneID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if !emit(ctx, changes, ZoneChange{Time: c.clock.Now(), Err: err}) {
+					return
+				}
+
+				continue
+			}
+
+			for _, change := range diffSnapshots(previous, current, c.clock.Now()) {
+				if !emit(ctx, changes, change) {
+					return
+				}
+			}
+
+			previous = current
+		}
+	}()
+
+	return changes, nil
+}
+
+func (c Client) snapshotRecords(ctx context.Context, zoneID string) (map[string]Record, error) {
+	records, err := c.GetRecords(ctx, zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := make(map[string]Record, len(records))
+
+	for _, record := range records {
+		snapshot[record.ID] = record
+	}
+
+	return snapshot, nil
+}
+
+// diffSnapshots returns the changes between two snapshots (records by ID), sorted by record ID.
+func diffSnapshots(previous, current map[string]Record, now time.Time) []ZoneChange {
+	var changes []ZoneChange
+
+	for id, record := range current {
+		old, ok := previous[id]
+
+		switch {
+		case !ok:
+			changes = append(changes, ZoneChange{Type: ChangeAdded, Time: now, Record: record})
+		case old.RecordType != record.RecordType || old.Name != record.Name || old.Content != record.Content || old.TTL != record.TTL:
+			old := old
+			changes = append(changes, ZoneChange{Type: ChangeModified, Time: now, Record: record, Previous: &old})
+		}
+	}
+
+	for id, record := range previous {
+		if _, ok := current[id]; !ok {
+			changes = append(changes, ZoneChange{Type: ChangeDeleted, Time: now, Record: record})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Record.ID < changes[j].Record.ID
+	})
+
+	return changes
+}
+
+func emit(ctx context.Context, changes chan<- ZoneChange, change ZoneChange) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case changes <- change:
+		return true
+	}
+}
@@ -0,0 +1,96 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WatchZone(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	client, mux := setupTestMux(t, WithClock(&fixedClock{now: now}))
+
+	snapshots := [][]Record{
+		{{ID: "1", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}},
+		{{ID: "1", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60}, {ID: "2", RecordType: TypeTXT, Name: "@", Content: "foo", TTL: 60}},
+		{{ID: "2", RecordType: TypeTXT, Name: "@", Content: "foo", TTL: 60}},
+	}
+
+	var mu sync.Mutex
+
+	polls := 0
+
+	mux.HandleFunc("/dns_zones/zzz/records", func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if polls >= len(snapshots) {
+			http.Error(rw, `{"status": 500, "error": "Internal Server Error"}`, http.StatusInternalServerError)
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(RecordsResponse{Records: snapshots[polls]})
+
+		polls++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := client.WatchZone(ctx, "zzz", 10*time.Millisecond)
+	require.NoError(t, err)
+
+	change := <-changes
+	assert.Equal(t, ZoneChange{Type: ChangeAdded, Time: now, Record: snapshots[1][1]}, change)
+
+	change = <-changes
+	assert.Equal(t, ZoneChange{Type: ChangeDeleted, Time: now, Record: snapshots[1][0]}, change)
+
+	change = <-changes
+	require.Error(t, change.Err)
+	assert.Empty(t, change.Type)
+
+	cancel()
+
+	for range changes {
+		// drains the channel until it's closed.
+	}
+}
+
+func TestClient_WatchZone_error(t *testing.T) {
+	client := setupTest(t, "/dns_zones/zzz/records", readFileHandler(http.MethodGet, http.StatusNotFound, "get-dns-zones-records-error.json"))
+
+	_, err := client.WatchZone(context.Background(), "zzz", time.Second)
+	require.Error(t, err)
+}
+
+func Test_diffSnapshots(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	previous := map[string]Record{
+		"1": {ID: "1", RecordType: TypeA, Name: "www", Content: "1.1.1.1", TTL: 60},
+		"2": {ID: "2", RecordType: TypeA, Name: "api", Content: "1.1.1.1", TTL: 60},
+	}
+
+	current := map[string]Record{
+		"1": {ID: "1", RecordType: TypeA, Name: "www", Content: "2.2.2.2", TTL: 60},
+		"3": {ID: "3", RecordType: TypeA, Name: "new", Content: "1.1.1.1", TTL: 60},
+	}
+
+	old := previous["1"]
+
+	expected := []ZoneChange{
+		{Type: ChangeModified, Time: now, Record: current["1"], Previous: &old},
+		{Type: ChangeDeleted, Time: now, Record: previous["2"]},
+		{Type: ChangeAdded, Time: now, Record: current["3"]},
+	}
+
+	assert.Equal(t, expected, diffSnapshots(previous, current, now))
+}
@@ -0,0 +1,182 @@
+package nodion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrZoneNotFound is returned when a zone cannot be found.
+var ErrZoneNotFound = errors.New("zone not found")
+
+// CreateZoneOptions are the options of CreateZoneWithOptions.
+type CreateZoneOptions struct {
+	// Records are the records to create in the new zone.
+	Records []Record
+
+	// RemoveDefaultRecords removes the records automatically created by Nodion with the zone,
+	// except the NS records of the apex.
+	RemoveDefaultRecords bool
+
+	// KeepDefaultRecord selects the records automatically created by Nodion to keep:
+	// the records for which it returns false are removed.
+	// The API always creates the default records, they are removed right after the creation of the zone.
+	// It takes precedence over RemoveDefaultRecords.
+	KeepDefaultRecord func(record Record) bool
+}
+
+// keepDefaultRecord reports whether a record automatically created by Nodion must be kept.
+func (o CreateZoneOptions) keepDefaultRecord(record Record) bool {
+	if o.KeepDefaultRecord != nil {
+		return o.KeepDefaultRecord(record)
+	}
+
+	return !o.RemoveDefaultRecords || isApexNS(record)
+}
+
+// CreateZoneWithOptions creates a new DNS Zone and applies its initial record set.
+// If one of the operations fails, the zone is deleted.
+func (c Client) CreateZoneWithOptions(ctx context.Context, name string, opts CreateZoneOptions) (*Zone, error) {
+	zone, err := c.CreateZone(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.bootstrapZone(ctx, zone, opts)
+	if err != nil {
+		_, errD := c.deleteZone(ctx, zone.ID)
+		if errD != nil {
+			return nil, fmt.Errorf("%w (rollback: delete zone %s: %v)", err, zone.Name, errD)
+		}
+
+		return nil, err
+	}
+
+	zone.Records = records
+
+	return zone, nil
+}
+
+// bootstrapZone applies the initial record set of a new zone, and returns the resulting records.
+func (c Client) bootstrapZone(ctx context.Context, zone *Zone, opts CreateZoneOptions) ([]Record, error) {
+	var records []Record
+
+	for _, record := range zone.Records {
+		if opts.keepDefaultRecord(record) {
+			records = append(records, record)
+			continue
+		}
+
+		_, err := c.DeleteRecord(ctx, zone.ID, record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("delete default record %s (%s): %w", record.Name, record.RecordType, err)
+		}
+	}
+
+	for _, record := range opts.Records {
+		newRecord, err := c.CreateRecord(ctx, zone.ID, record)
+		if err != nil {
+			return nil, fmt.Errorf("create record %s (%s): %w", record.Name, record.RecordType, err)
+		}
+
+		records = append(records, *newRecord)
+	}
+
+	return records, nil
+}
+
+func isApexNS(record Record) bool {
+	return record.RecordType == TypeNS && record.Name == "@"
+}
+
+// CloneOptions are the options of CloneZone.
+type CloneOptions struct {
+	// Rewrite is applied to each record (after the name rewriting) before its creation in the new zone.
+	// The record is skipped if it returns false.
+	Rewrite func(record Record) (Record, bool)
+
+	// KeepDefaultRecords keeps the records automatically created by Nodion in the new zone.
+	KeepDefaultRecords bool
+}
+
+// CloneZone creates a new zone with a copy of the records of an existing zone.
+// The references to the source zone name (in the names and the contents of the records) are rewritten with the new name.
+// The NS records of the apex are not copied: the new zone has its own nameservers.
+func (c Client) CloneZone(ctx context.Context, sourceZoneID, newName string, opts CloneOptions) (*Zone, error) {
+	source, err := c.getZone(ctx, sourceZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.GetRecords(ctx, sourceZoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var toCreate []Record
+
+	for _, record := range records {
+		if isApexNS(record) {
+			continue
+		}
+
+		record = Record{
+			RecordType: record.RecordType,
+			Name:       rewriteZoneName(record.Name, source.Name, newName),
+			Content:    rewriteZoneName(record.Content, source.Name, newName),
+			TTL:        record.TTL,
+		}
+
+		if opts.Rewrite != nil {
+			var keep bool
+
+			record, keep = opts.Rewrite(record)
+			if !keep {
+				continue
+			}
+		}
+
+		toCreate = append(toCreate, record)
+	}
+
+	return c.CreateZoneWithOptions(ctx, newName, CreateZoneOptions{
+		Records:              toCreate,
+		RemoveDefaultRecords: !opts.KeepDefaultRecords,
+	})
+}
+
+// getZone gets a zone by its ID.
+func (c Client) getZone(ctx context.Context, zoneID string) (*Zone, error) {
+	zones, err := c.GetZones(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, zone := range zones {
+		if zone.ID == zoneID {
+			return &zone, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrZoneNotFound, zoneID)
+}
+
+// rewriteZoneName replaces the zone name suffix of a domain name.
+func rewriteZoneName(value, oldName, newName string) string {
+	fqdn := strings.HasSuffix(value, ".")
+	value = strings.TrimSuffix(value, ".")
+
+	switch {
+	case strings.EqualFold(value, oldName):
+		value = newName
+	case strings.HasSuffix(strings.ToLower(value), "."+strings.ToLower(oldName)):
+		value = value[:len(value)-len(oldName)] + newName
+	}
+
+	if fqdn {
+		value += "."
+	}
+
+	return value
+}
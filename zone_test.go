@@ -0,0 +1,162 @@
+package nodion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleZoneID = "52be5f1b-fee7-4a42-b668-85890c41be5b"
+
+func TestClient_CreateZoneWithOptions(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records/", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	opts := CreateZoneOptions{
+		Records: []Record{
+			{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60},
+		},
+		RemoveDefaultRecords: true,
+	}
+
+	zone, err := client.CreateZoneWithOptions(context.Background(), "nodionsample.com", opts)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, record := range zone.Records {
+		ids = append(ids, record.ID)
+	}
+
+	expected := []string{
+		"d13e85ce-7d04-4770-9197-19f87f35e6a8",
+		"f5454bf7-f89b-45a4-981f-7783102fd389",
+		"748d688a-3004-4b84-b8b8-8cb2e07c5c71",
+	}
+
+	assert.Equal(t, expected, ids)
+}
+
+func TestClient_CreateZoneWithOptions_keepDefaultRecord(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	var deleted []string
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records/", func(rw http.ResponseWriter, req *http.Request) {
+		deleted = append(deleted, path.Base(req.URL.Path))
+		readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json")(rw, req)
+	})
+
+	opts := CreateZoneOptions{
+		RemoveDefaultRecords: true,
+		KeepDefaultRecord: func(record Record) bool {
+			return record.RecordType == TypeNS || record.Name == "@"
+		},
+	}
+
+	zone, err := client.CreateZoneWithOptions(context.Background(), "nodionsample.com", opts)
+	require.NoError(t, err)
+
+	expected := []string{
+		"60a0647b-0b08-4dc0-8d51-4e21c799457c",
+		"b4748041-f3b2-40f3-9217-9af016c5937f",
+	}
+
+	assert.Equal(t, expected, deleted)
+	assert.Len(t, zone.Records, 3)
+}
+
+func TestClient_CreateZoneWithOptions_rollback(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	var deleted bool
+
+	mux.HandleFunc("/dns_zones", readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records", readFileHandler(http.MethodPost, http.StatusBadRequest, "create-dns-zone-record-error.json"))
+	mux.HandleFunc("/dns_zones/"+sampleZoneID, func(rw http.ResponseWriter, req *http.Request) {
+		deleted = true
+		readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone.json")(rw, req)
+	})
+
+	opts := CreateZoneOptions{
+		Records: []Record{
+			{RecordType: TypeA, Name: "www", Content: "1.2.3.4", TTL: 60},
+		},
+	}
+
+	_, err := client.CreateZoneWithOptions(context.Background(), "nodionsample.com", opts)
+	require.Error(t, err)
+
+	assert.True(t, deleted)
+}
+
+func TestClient_CloneZone(t *testing.T) {
+	client, mux := setupTestMux(t)
+
+	mux.HandleFunc("/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones.json")(rw, req)
+			return
+		}
+
+		readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone.json")(rw, req)
+	})
+
+	var created []Record
+
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			readFileHandler(http.MethodGet, http.StatusOK, "get-dns-zones-records.json")(rw, req)
+			return
+		}
+
+		var record Record
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&record))
+
+		created = append(created, record)
+
+		readFileHandler(http.MethodPost, http.StatusOK, "create-dns-zone-record.json")(rw, req)
+	})
+	mux.HandleFunc("/dns_zones/"+sampleZoneID+"/records/", readFileHandler(http.MethodDelete, http.StatusOK, "delete-dns-zone-record.json"))
+
+	opts := CloneOptions{
+		Rewrite: func(record Record) (Record, bool) {
+			return record, record.Name != "*"
+		},
+	}
+
+	_, err := client.CloneZone(context.Background(), sampleZoneID, "example.org", opts)
+	require.NoError(t, err)
+
+	var names []string
+	for _, record := range created {
+		names = append(names, record.Name)
+	}
+
+	assert.Equal(t, []string{"@", "www"}, names)
+}
+
+func Test_rewriteZoneName(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "@", expected: "@"},
+		{value: "example.com", expected: "example.org"},
+		{value: "mail.Example.com.", expected: "mail.example.org."},
+		{value: "www.notexample.com", expected: "www.notexample.com"},
+		{value: "1.2.3.4", expected: "1.2.3.4"},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, rewriteZoneName(test.value, "example.com", "example.org"), test.value)
+	}
+}